@@ -0,0 +1,86 @@
+package app_test
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/stretchr/testify/require"
+
+	"ghostcloud/app"
+	"ghostcloud/testutil/sample"
+	v2 "ghostcloud/x/ghostcloud/migrations/v2"
+	ghostcloudtypes "ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func TestUpgradeHandler(t *testing.T) {
+	encodingConfig := app.MakeEncodingConfig()
+	cdc := encodingConfig.Marshaler
+	ghostcloudApp := app.New(
+		log.NewNopLogger(),
+		dbm.NewMemDB(),
+		nil,
+		true,
+		map[int64]bool{},
+		t.TempDir(),
+		0,
+		encodingConfig,
+		simtestutil.EmptyAppOptions{},
+	)
+	ctx := ghostcloudApp.BaseApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	// Module versions before the upgrade, the authz module is added by the upgrade
+	fromVM := ghostcloudApp.ModuleManager().GetVersionMap()
+	fromVM[ghostcloudtypes.ModuleName] = 1
+	delete(fromVM, authz.ModuleName)
+	ghostcloudApp.UpgradeKeeper.SetModuleVersionMap(ctx, fromVM)
+
+	// Consensus version 1 state: params in the x/params subspace and deployments under the raw concatenated keys
+	ghostcloudApp.GetSubspace(ghostcloudtypes.ModuleName).Set(ctx, ghostcloudtypes.KeyMaxNameSize, int64(64))
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	store := ctx.KVStore(ghostcloudApp.GetKey(ghostcloudtypes.StoreKey))
+	meta := &ghostcloudtypes.Meta{Creator: addr.String(), Name: "foo", Domain: "foo.com"}
+	prefix.NewStore(store, v2.LegacyDeploymentMetaKeyPrefix).Set(v2.LegacyDeploymentKey(addr, "foo"), cdc.MustMarshal(meta))
+	itemKey := v2.LegacyDeploymentItemKey(addr, "foo", "index.html")
+	prefix.NewStore(store, v2.LegacyDeploymentItemMetaPrefix).Set(itemKey, cdc.MustMarshal(&ghostcloudtypes.ItemMeta{Path: "index.html"}))
+	prefix.NewStore(store, v2.LegacyDeploymentItemContentPrefix).Set(itemKey, cdc.MustMarshal(&ghostcloudtypes.ItemContent{Content: []byte("<h1>foo</h1>")}))
+
+	ghostcloudApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: app.UpgradeName, Height: 10})
+
+	toVM := ghostcloudApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, uint64(2), toVM[ghostcloudtypes.ModuleName])
+	require.Contains(t, toVM, authz.ModuleName)
+
+	k := ghostcloudApp.GhostcloudKeeper
+	params := k.GetParams(ctx)
+	require.Equal(t, int64(64), params.MaxNameSize)
+	require.Equal(t, ghostcloudtypes.DefaultMaxPayloadSize, params.MaxPayloadSize)
+
+	got, found := k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, "foo.com", got.GetDomain())
+
+	got, found = k.GetMetaByDomain(ctx, "foo.com")
+	require.True(t, found)
+	require.Equal(t, "foo", got.GetName())
+
+	got, found = k.GetMetaByID(ctx, got.GetId())
+	require.True(t, found)
+	require.Equal(t, "foo", got.GetName())
+
+	content, found, err := k.GetItemContent(ctx, addr, "foo", "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("<h1>foo</h1>"), content.GetContent())
+
+	require.Equal(t, uint64(1), k.GetLatestRevisionNumber(ctx, addr, "foo"))
+}
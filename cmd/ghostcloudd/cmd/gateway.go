@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"ghostcloud/x/ghostcloud/client/gateway"
+	"ghostcloud/x/ghostcloud/types"
+)
+
+const (
+	FlagListenAddr          = "listen"
+	FlagDomainRouting       = "domain-routing"
+	defaultListenAddr       = "127.0.0.1:8080"
+	gatewayReadTimeout      = 10 * time.Second
+	gatewayShutdownDuration = 5 * time.Second
+)
+
+// GatewayCmd returns a command serving the deployments as websites over HTTP.
+func GatewayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gateway",
+		Short: "Serve deployments as websites over HTTP",
+		Long: `Serve deployments as websites over HTTP.
+
+Files are served from /<creator>/<name>/<path> using the Content query of the configured node.
+Directory paths are resolved to their index.html file.
+
+With --domain-routing, requests whose Host header matches the domain of a deployment are served
+from the root of that deployment, falling back to path routing for unknown hosts. Only the domains
+verified through a domain claim are routed.
+
+All the deployments served through path routing share the origin of the gateway: their scripts can
+read each other's cookies, local storage and service workers. Serve untrusted deployments from
+their own verified domain, or from a gateway per origin.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			listenAddr, err := cmd.Flags().GetString(FlagListenAddr)
+			if err != nil {
+				return err
+			}
+
+			domainRouting, err := cmd.Flags().GetBool(FlagDomainRouting)
+			if err != nil {
+				return err
+			}
+
+			var opts []gateway.Option
+			if domainRouting {
+				opts = append(opts, gateway.WithDomainRouting())
+			}
+
+			server := &http.Server{
+				Addr:              listenAddr,
+				Handler:           gateway.NewHandler(types.NewQueryClient(clientCtx), opts...),
+				ReadHeaderTimeout: gatewayReadTimeout,
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			errCh := make(chan error, 1)
+			go func() {
+				cmd.Printf("Serving deployments on http://%s\n", listenAddr)
+				errCh <- server.ListenAndServe()
+			}()
+
+			select {
+			case err := <-errCh:
+				return err
+			case <-ctx.Done():
+				shutdownCtx, cancel := context.WithTimeout(context.Background(), gatewayShutdownDuration)
+				defer cancel()
+				if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+					return err
+				}
+				return nil
+			}
+		},
+	}
+
+	cmd.Flags().String(FlagListenAddr, defaultListenAddr, "The address the gateway listens on")
+	cmd.Flags().Bool(FlagDomainRouting, false, "Route requests to deployments using their Host header")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
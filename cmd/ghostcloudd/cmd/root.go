@@ -123,6 +123,7 @@ func initRootCmd(
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debug.Cmd(),
 		config.Cmd(),
+		GatewayCmd(),
 		// this line is used by starport scaffolding # root/commands
 	)
 
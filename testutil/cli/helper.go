@@ -16,7 +16,7 @@ import (
 
 const (
 	NewDescription = "new description"
-	NewDomain      = "newdomain"
+	NewDomain      = "newdomain.com"
 	NewContent     = "<h1>new content</h1>"
 	IndexHTML      = "index.html"
 )
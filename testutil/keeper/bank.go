@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MockBankKeeper is an in-memory implementation of the bank keeper expected by the module.
+type MockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+var _ types.BankKeeper = (*MockBankKeeper)(nil)
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// Fund adds coins to the balance of an account.
+func (b *MockBankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+// Balance returns the balance of an account.
+func (b *MockBankKeeper) Balance(addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+// ModuleBalance returns the balance of a module account.
+func (b *MockBankKeeper) ModuleBalance(module string) sdk.Coins {
+	return b.Balance(authtypes.NewModuleAddress(module))
+}
+
+func (b *MockBankKeeper) SpendableCoins(_ sdk.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.Balance(addr)
+}
+
+func (b *MockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *MockBankKeeper) SendCoinsFromModuleToAccount(_ sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *MockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := b.Balance(from).SafeSub(amt...)
+	if hasNeg {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.Balance(from), amt)
+	}
+	b.balances[from.String()] = balance
+	b.Fund(to, amt)
+	return nil
+}
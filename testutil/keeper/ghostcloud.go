@@ -12,7 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	typesparams "github.com/cosmos/cosmos-sdk/x/params/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	tmdb "github.com/cometbft/cometbft-db"
 	"github.com/cometbft/cometbft/libs/log"
@@ -34,6 +35,24 @@ type MsgServerTestCase struct {
 }
 
 func GhostcloudKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	k, ctx, _ := GhostcloudKeeperWithBank(t)
+	return k, ctx
+}
+
+// GhostcloudKeeperWithBank returns a keeper backed by an in-memory bank keeper.
+func GhostcloudKeeperWithBank(t testing.TB) (*keeper.Keeper, sdk.Context, *MockBankKeeper) {
+	k, ctx, bankKeeper, _ := newGhostcloudKeeper(t)
+	return k, ctx, bankKeeper
+}
+
+// GhostcloudKeeperWithStoreKey returns a keeper along with its store key, e.g., to seed legacy state before running
+// the store migrations.
+func GhostcloudKeeperWithStoreKey(t testing.TB) (*keeper.Keeper, sdk.Context, storetypes.StoreKey) {
+	k, ctx, _, storeKey := newGhostcloudKeeper(t)
+	return k, ctx, storeKey
+}
+
+func newGhostcloudKeeper(t testing.TB) (*keeper.Keeper, sdk.Context, *MockBankKeeper, storetypes.StoreKey) {
 	storeKey := sdk.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 
@@ -46,25 +65,21 @@ func GhostcloudKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
-	paramsSubspace := typesparams.NewSubspace(cdc,
-		types.Amino,
-		storeKey,
-		memStoreKey,
-		"GhostcloudParams",
-	)
+	bankKeeper := NewMockBankKeeper()
 	k := keeper.NewKeeper(
 		cdc,
 		storeKey,
 		memStoreKey,
-		paramsSubspace,
+		bankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	// Initialize params
-	k.SetParams(ctx, types.DefaultParams())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
 
-	return k, ctx
+	return k, ctx, bankKeeper, storeKey
 }
 
 func setDeployments(ctx sdk.Context, k *keeper.Keeper, metas []*types.Meta, datasets []*types.Dataset) {
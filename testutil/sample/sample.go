@@ -1,10 +1,13 @@
 package sample
 
 import (
+	"archive/tar"
 	"archive/zip"
 	"bytes"
+	"compress/gzip"
 	"crypto/rand"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -12,6 +15,8 @@ import (
 
 	"ghostcloud/x/ghostcloud/types"
 
+	"github.com/klauspost/compress/zstd"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -176,7 +181,7 @@ func createMeta(addr string, i int) *types.Meta {
 		Creator:     addr,
 		Name:        strconv.Itoa(i),
 		Description: strconv.Itoa(i),
-		Domain:      strconv.Itoa(i),
+		Domain:      strconv.Itoa(i) + "." + addr,
 	}
 }
 func CreateMeta(i int) *types.Meta {
@@ -212,20 +217,39 @@ func CreateArchive() *types.Archive {
 }
 
 func CreateZip(fileName string, body string) []byte {
-	return createInMemoryZip(fileName, body)
+	return createInMemoryZip(ArchiveFile{fileName, body})
 }
 
 func CreateItem(i int) *types.Item {
-	return &types.Item{
-		Meta:    &types.ItemMeta{Path: strconv.Itoa(i)},
-		Content: &types.ItemContent{Content: []byte{byte(i)}},
-	}
+	return createItem(strconv.Itoa(i), []byte{byte(i)})
 }
 
 func CreateItemWithIndexHtml() *types.Item {
+	return createItem("index.html", []byte{0x00})
+}
+
+// createItem returns an item as stored by the module, i.e., with the hash, size and content type of its content.
+func createItem(path string, content []byte) *types.Item {
 	return &types.Item{
-		Meta:    &types.ItemMeta{Path: "index.html"},
-		Content: &types.ItemContent{Content: []byte{0x00}},
+		Meta: &types.ItemMeta{
+			Path:        path,
+			Hash:        types.ContentHash(content),
+			Size_:       uint64(len(content)),
+			ContentType: types.DetectContentType(path, content),
+		},
+		Content: &types.ItemContent{Content: content},
+	}
+}
+
+// SetContentMetas sets the hash, size and content type of the content of each item, as done by the module when
+// storing a dataset.
+func SetContentMetas(dataset *types.Dataset) {
+	for _, item := range dataset.GetItems() {
+		item.Meta.Hash = types.ContentHash(item.GetContent().GetContent())
+		item.Meta.Size_ = uint64(len(item.GetContent().GetContent()))
+		if item.Meta.ContentType == "" {
+			item.Meta.ContentType = types.DetectContentType(item.Meta.Path, item.GetContent().GetContent())
+		}
 	}
 }
 
@@ -246,20 +270,65 @@ func CreateNItemsWithIndexHtml(n int) []*types.Item {
 	return items
 }
 
-func createInMemoryZip(fileName string, body string) []byte {
+// ArchiveFile is a file of an in-memory archive.
+type ArchiveFile struct {
+	Name, Body string
+}
+
+// CreateZipWithFiles returns an in-memory zip archive holding the files, in order.
+func CreateZipWithFiles(files ...ArchiveFile) []byte {
+	return createInMemoryZip(files...)
+}
+
+// CreateTarballWithFiles returns an in-memory TarGz or TarZstd archive holding the files, in order. Names ending
+// with a slash are stored as directories.
+func CreateTarballWithFiles(archiveType types.ArchiveType, files ...ArchiveFile) []byte {
+	var buffer bytes.Buffer
+
+	var compressor io.WriteCloser
+	var err error
+	switch archiveType {
+	case types.ArchiveType_TarGz:
+		compressor = gzip.NewWriter(&buffer)
+	case types.ArchiveType_TarZstd:
+		compressor, err = zstd.NewWriter(&buffer)
+	default:
+		err = fmt.Errorf("unsupported archive type: %s", archiveType)
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	tarWriter := tar.NewWriter(compressor)
+	for _, file := range files {
+		header := &tar.Header{Name: file.Name, Mode: 0o644, Size: int64(len(file.Body)), Typeflag: tar.TypeReg}
+		if strings.HasSuffix(file.Name, "/") {
+			header = &tar.Header{Name: file.Name, Mode: 0o755, Typeflag: tar.TypeDir}
+		}
+		if err := tarWriter.WriteHeader(header); err != nil {
+			panic(err)
+		}
+		if _, err := tarWriter.Write([]byte(file.Body)); err != nil {
+			panic(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		panic(err)
+	}
+	if err := compressor.Close(); err != nil {
+		panic(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func createInMemoryZip(files ...ArchiveFile) []byte {
 	// Step 1: Create a buffer to hold the zip archive's data in memory
 	var buffer bytes.Buffer
 
 	// Step 2: Create a new zip archive writing to the buffer
 	zipWriter := zip.NewWriter(&buffer)
 
-	// Dummy data to put into the zip file
-	files := []struct {
-		Name, Body string
-	}{
-		{fileName, body},
-	}
-
 	// Step 3: Add files to the archive
 	for _, file := range files {
 		f, err := zipWriter.Create(file.Name)
@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"ghostcloud/x/ghostcloud/types"
@@ -16,13 +18,17 @@ import (
 const FlagFilterBy = "filter-by"
 const FlagFilterValue = "filter-value"
 const FlagFilterOperator = "filter-operator"
+const FlagFilter = "filter"
+const FlagFilterAny = "filter-any"
+const FlagSortBy = "sort-by"
 
-var validFilterByChoices = []string{"creator"}
-var validFilterByOperators = []string{"equal", "not_equal"}
+var validFilterByChoices = []string{"creator", "name", "domain", "description"}
+var validFilterByOperators = []string{"equal", "not_equal", "contains", "not_contains", "prefix", "regex"}
+var validSortByChoices = []string{"created_height", "updated_height", "name", "total_size"}
 
 func addFilterByFlags(cmd *cobra.Command, filterBy *string) {
 	f := cmd.Flags()
-	f.StringVarP(filterBy, FlagFilterBy, "", "", "Apply filter to listing (options: creator)")
+	f.StringVarP(filterBy, FlagFilterBy, "", "", "Apply filter to listing (options: creator, name, domain, description)")
 }
 
 func addFilterValueFlag(cmd *cobra.Command, filterValue *string) {
@@ -32,7 +38,29 @@ func addFilterValueFlag(cmd *cobra.Command, filterValue *string) {
 
 func addFilterOperatorFlag(cmd *cobra.Command, filterOperator *string) {
 	f := cmd.Flags()
-	f.StringVarP(filterOperator, FlagFilterOperator, "", "equal", "The operator for the filter (options: eq, neq, contains, not_contains)")
+	f.StringVarP(filterOperator, FlagFilterOperator, "", "equal", "The operator for the filter (options: equal, not_equal, contains, not_contains, prefix, regex)")
+}
+
+func addFilterFlags(cmd *cobra.Command, filters *[]string, anyFilters *[]string) {
+	f := cmd.Flags()
+	f.StringArrayVar(filters, FlagFilter, nil, "Filter expression as field:operator:value, e.g., name:prefix:blog. Repeat to match all the expressions")
+	f.StringArrayVar(anyFilters, FlagFilterAny, nil, "Filter expression as field:operator:value. Repeat to match at least one of the expressions")
+}
+
+func addSortByFlag(cmd *cobra.Command, sortBy *string) {
+	f := cmd.Flags()
+	f.StringVar(sortBy, FlagSortBy, "", "Sort the listing, in descending order with --reverse (options: created_height, updated_height, name, total_size)")
+}
+
+// parseSortBy returns the sort order of the listing. The default order is by creator address, then by name.
+func parseSortBy(sortBy string) (types.SortBy, error) {
+	if sortBy == "" {
+		return types.SortBy_SORT_BY_UNSPECIFIED, nil
+	}
+	if !isValidFilterChoice(sortBy, validSortByChoices) {
+		return 0, fmt.Errorf("invalid choice for --%s: %s, valid choices are: %v", FlagSortBy, sortBy, validSortByChoices)
+	}
+	return types.SortBy(types.SortBy_value["SORT_BY_"+strings.ToUpper(sortBy)]), nil
 }
 
 func isValidFilterChoice(choice string, validChoices []string) bool {
@@ -44,60 +72,82 @@ func isValidFilterChoice(choice string, validChoices []string) bool {
 	return false
 }
 
-func handleFilterBy(filterBy string, filterByValue string, filterByOperator string) error {
-	if filterBy != "" {
-		if !isValidFilterChoice(filterBy, validFilterByChoices) {
-			return fmt.Errorf("invalid choice for --%s: %s, valid choices are: %v", FlagFilterBy, filterBy, validFilterByChoices)
-		}
-		if err := handleFilterByValue(filterBy, filterByValue); err != nil {
-			return err
-		}
-		if err := handleFilterByOperator(filterByOperator); err != nil {
-			return err
-		}
-
+// parseFilter parses a filter from its field, operator and value.
+func parseFilter(filterBy string, filterByValue string, filterByOperator string) (*types.Filter, error) {
+	if !isValidFilterChoice(filterBy, validFilterByChoices) {
+		return nil, fmt.Errorf("invalid filter field: %s, valid choices are: %v", filterBy, validFilterByChoices)
 	}
-	return nil
-}
-
-func handleFilterByValue(filterBy string, filterByValue string) error {
-	if filterByValue == "" {
-		return fmt.Errorf("invalid value for --%s: %s", FlagFilterValue, filterByValue)
+	if !isValidFilterChoice(filterByOperator, validFilterByOperators) {
+		return nil, fmt.Errorf("invalid filter operator: %s, valid choices are: %v", filterByOperator, validFilterByOperators)
 	}
-
-	switch filterBy {
-	case "creator":
-		if _, err := sdk.AccAddressFromBech32(filterByValue); err != nil {
-			return fmt.Errorf("invalid address for --%s: %s: %v", FlagFilterValue, filterByValue, err)
-		}
+	if err := handleFilterByValue(filterBy, filterByValue, filterByOperator); err != nil {
+		return nil, err
 	}
-	return nil
+
+	return &types.Filter{
+		Field:    types.Filter_Field(types.Filter_Field_value[strings.ToUpper(filterBy)]),
+		Operator: types.Filter_Operator(types.Filter_Operator_value[strings.ToUpper(filterByOperator)]),
+		Value:    filterByValue,
+	}, nil
 }
 
-func handleFilterByOperator(filterByOperator string) error {
-	if filterByOperator != "" && !isValidFilterChoice(filterByOperator, validFilterByOperators) {
-		return fmt.Errorf("invalid choice for --%s: %s, valid choices are: %v", FlagFilterOperator, filterByOperator, validFilterByOperators)
+// parseFilterExpression parses a `field:operator:value` filter expression. The value may contain colons.
+func parseFilterExpression(expr string) (*types.Filter, error) {
+	parts := strings.SplitN(expr, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid filter expression: %s, expected field:operator:value", expr)
 	}
-	return nil
+	return parseFilter(parts[0], parts[2], parts[1])
 }
 
-func handleFilterFlags(filterBy string, filterByValue string, filterByOperator string) error {
-	if err := handleFilterBy(filterBy, filterByValue, filterByOperator); err != nil {
-		return err
+func handleFilterByValue(filterBy string, filterByValue string, filterByOperator string) error {
+	switch filterByOperator {
+	case "equal", "not_equal":
+		if filterBy == "creator" {
+			if _, err := sdk.AccAddressFromBech32(filterByValue); err != nil {
+				return fmt.Errorf("invalid address for filter value: %s: %v", filterByValue, err)
+			}
+		}
+	case "regex":
+		if _, err := regexp.Compile(filterByValue); err != nil {
+			return fmt.Errorf("invalid regular expression for filter value: %s: %v", filterByValue, err)
+		}
+	default:
+		if filterByValue == "" {
+			return fmt.Errorf("invalid value for filter: %s", filterByValue)
+		}
 	}
 	return nil
 }
 
-func buildFilters(filterBy string, filterByValue string, filterByOperator string) []*types.Filter {
+// buildFilters returns the filters that must all match and the filter groups of which one must match.
+func buildFilters(filterBy string, filterByValue string, filterByOperator string, exprs []string, anyExprs []string) ([]*types.Filter, []*types.FilterGroup, error) {
 	var filters []*types.Filter
 	if filterBy != "" {
-		filters = append(filters, &types.Filter{
-			Field:    types.Filter_Field(types.Filter_Field_value[strings.ToUpper(filterBy)]),
-			Operator: types.Filter_Operator(types.Filter_Operator_value[strings.ToUpper(filterByOperator)]),
-			Value:    filterByValue,
-		})
+		filter, err := parseFilter(filterBy, filterByValue, filterByOperator)
+		if err != nil {
+			return nil, nil, err
+		}
+		filters = append(filters, filter)
+	}
+	for _, expr := range exprs {
+		filter, err := parseFilterExpression(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		filters = append(filters, filter)
 	}
-	return filters
+
+	var groups []*types.FilterGroup
+	for _, expr := range anyExprs {
+		filter, err := parseFilterExpression(expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		groups = append(groups, &types.FilterGroup{Filters: []*types.Filter{filter}})
+	}
+
+	return filters, groups, nil
 }
 
 // GetQueryCmd returns the cli query commands for this module
@@ -113,6 +163,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListDeployments())
+	cmd.AddCommand(CmdShowDeploymentByDomain())
+	cmd.AddCommand(CmdShowDomainClaim())
+	cmd.AddCommand(CmdListRevisions())
+	cmd.AddCommand(CmdShowDeploymentById())
+	cmd.AddCommand(CmdShowTransfer())
+	cmd.AddCommand(CmdListCollaborators())
+	cmd.AddCommand(CmdShowDeployment())
+	cmd.AddCommand(CmdListItems())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -122,13 +180,31 @@ func CmdListDeployments() *cobra.Command {
 	var filterBy string
 	var filterByValue string
 	var filterByOperator string
+	var filterExprs []string
+	var filterAnyExprs []string
+	var sortBy string
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "list all deployments",
-		PreRunE: func(cmd *cobra.Command, args []string) error {
-			return handleFilterFlags(filterBy, filterByValue, filterByOperator)
-		},
+		Long: `List all deployments, optionally filtered.
+
+Filter expressions have the form field:operator:value, where field is one of creator, name, domain or description,
+and operator is one of equal, not_equal, contains, not_contains, prefix or regex. Deployments must match all the
+--filter expressions and, if any, at least one of the --filter-any expressions.
+
+Deployments are listed by creator address, then by name, unless --sort-by is set.`,
+		Example: `list --filter name:prefix:blog --filter-any domain:contains:example.com --filter-any description:regex:'(?i)blog'
+list --sort-by updated_height --reverse --limit 10`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			filters, groups, err := buildFilters(filterBy, filterByValue, filterByOperator, filterExprs, filterAnyExprs)
+			if err != nil {
+				return err
+			}
+			sortOrder, err := parseSortBy(sortBy)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -142,12 +218,10 @@ func CmdListDeployments() *cobra.Command {
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QueryMetasRequest{
-				Pagination: pageReq,
-			}
-
-			filters := buildFilters(filterBy, filterByValue, filterByOperator)
-			if len(filters) > 0 {
-				params.Filters = filters
+				Filters:      filters,
+				Pagination:   pageReq,
+				FilterGroups: groups,
+				SortBy:       sortOrder,
 			}
 
 			res, err := queryClient.Metas(cmd.Context(), params)
@@ -161,6 +235,264 @@ func CmdListDeployments() *cobra.Command {
 	addFilterByFlags(cmd, &filterBy)
 	addFilterValueFlag(cmd, &filterByValue)
 	addFilterOperatorFlag(cmd, &filterByOperator)
+	addFilterFlags(cmd, &filterExprs, &filterAnyExprs)
+	addSortByFlag(cmd, &sortBy)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDeploymentByDomain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "domain [domain]",
+		Short: "show the deployment serving a domain",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeploymentByDomain(cmd.Context(), &types.QueryDeploymentByDomainRequest{
+				Domain: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDomainClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "domain-claim [creator] [name]",
+		Short: "show the pending domain claim of a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DomainClaim(cmd.Context(), &types.QueryDomainClaimRequest{
+				Creator: args[0],
+				Name:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListRevisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revisions [creator] [name]",
+		Short: "list the revisions of a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Revisions(cmd.Context(), &types.QueryRevisionsRequest{
+				Creator:    args[0],
+				Name:       args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDeploymentById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment-by-id [id]",
+		Short: "show the deployment with a stable identifier",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid deployment id: %v", err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DeploymentById(cmd.Context(), &types.QueryDeploymentByIdRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [creator] [name]",
+		Short: "show the pending ownership transfer of a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Transfer(cmd.Context(), &types.QueryTransferRequest{
+				Creator: args[0],
+				Name:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListCollaborators() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collaborators [creator] [name]",
+		Short: "list the collaborators of a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Collaborators(cmd.Context(), &types.QueryCollaboratorsRequest{
+				Creator:    args[0],
+				Name:       args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowDeployment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show [creator] [name]",
+		Short: "show the meta of a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Meta(cmd.Context(), &types.QueryMetaRequest{
+				Creator: args[0],
+				Name:    args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdListItems() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ls [creator] [name]",
+		Short: "list the files of a deployment, without their content",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Items(cmd.Context(), &types.QueryItemsRequest{
+				Creator:    args[0],
+				Name:       args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -1,10 +1,14 @@
 package cli_test
 
 import (
+	"fmt"
+	"regexp"
+	"sort"
 	"testing"
 
 	"ghostcloud/testutil/keeper"
 	"ghostcloud/testutil/network"
+	"ghostcloud/testutil/sample"
 	"ghostcloud/x/ghostcloud/client/cli"
 	"ghostcloud/x/ghostcloud/types"
 
@@ -12,6 +16,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type QueryTestCase struct {
@@ -24,7 +29,20 @@ type QueryTestCase struct {
 func metasFromDeployments(deployments []*types.Deployment) []*types.Meta {
 	metas := make([]*types.Meta, len(deployments))
 	for i, deployment := range deployments {
-		metas[i] = deployment.Meta
+		meta := *deployment.Meta
+		// The JSON output holds an empty deposit list, not a null one
+		if meta.Deposit == nil {
+			meta.Deposit = sdk.Coins{}
+		}
+		// Genesis deployments without identifier are numbered in order
+		if meta.Id == 0 {
+			meta.Id = uint64(i + 1)
+		}
+		// The total size is derived from the files at genesis
+		for _, item := range deployment.Dataset.GetItems() {
+			meta.TotalSize += uint64(len(item.GetContent().GetContent()))
+		}
+		metas[i] = &meta
 	}
 	return metas
 }
@@ -57,9 +75,102 @@ func testListDeployments(t *testing.T, nc *network.Context, commonFlags []string
 	})
 }
 
+func testFilterDeployments(t *testing.T, nc *network.Context, commonFlags []string, objs []*types.Deployment) {
+	metas := metasFromDeployments(objs)
+	nameFilter := func(flag string, i int) string {
+		return fmt.Sprintf(network.FlagPattern, flag, "name:equal:"+objs[i].Meta.Name)
+	}
+
+	runQueryTest(t, nc, &QueryTestCase{
+		name:  "filter",
+		args:  append([]string{nameFilter(cli.FlagFilter, 0)}, commonFlags...),
+		metas: metas[:1],
+	})
+	runQueryTest(t, nc, &QueryTestCase{
+		name:  "filter any",
+		args:  append([]string{nameFilter(cli.FlagFilterAny, 0), nameFilter(cli.FlagFilterAny, 1)}, commonFlags...),
+		metas: metas[:2],
+	})
+	runQueryTest(t, nc, &QueryTestCase{
+		name: "filter and filter any",
+		args: append([]string{
+			fmt.Sprintf(network.FlagPattern, cli.FlagFilter, "creator:not_equal:"+sample.AccAddress()),
+			fmt.Sprintf(network.FlagPattern, cli.FlagFilter, "name:regex:^"+regexp.QuoteMeta(objs[1].Meta.Name)+"$"),
+			nameFilter(cli.FlagFilterAny, 0),
+			nameFilter(cli.FlagFilterAny, 1),
+		}, commonFlags...),
+		metas: metas[1:2],
+	})
+
+	invalid := []string{"name:equal", "owner:equal:foo", "name:greater_than:foo", "name:regex:(", "creator:equal:invalid"}
+	for _, expr := range invalid {
+		args := append([]string{fmt.Sprintf(network.FlagPattern, cli.FlagFilter, expr)}, commonFlags...)
+		_, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListDeployments(), args)
+		require.Error(t, err, expr)
+	}
+}
+
+func testSortDeployments(t *testing.T, nc *network.Context, commonFlags []string, objs []*types.Deployment) {
+	metas := metasFromDeployments(objs)
+	sort.Slice(metas, func(i, j int) bool { return metas[i].Name > metas[j].Name })
+
+	args := append([]string{fmt.Sprintf(network.FlagPattern, cli.FlagSortBy, "name"), "--reverse"}, commonFlags...)
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListDeployments(), args)
+	require.NoError(t, err)
+	var resp types.QueryMetasResponse
+	require.NoError(t, nc.Net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Equal(t, metas, resp.Meta)
+
+	args = append([]string{fmt.Sprintf(network.FlagPattern, cli.FlagSortBy, "size")}, commonFlags...)
+	_, err = clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListDeployments(), args)
+	require.ErrorContains(t, err, "invalid choice")
+}
+
+func testShowDeployment(t *testing.T, nc *network.Context, commonFlags []string, objs []*types.Deployment) {
+	metas := metasFromDeployments(objs)
+	for i, obj := range objs {
+		args := append([]string{obj.Meta.Creator, obj.Meta.Name}, commonFlags...)
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdShowDeployment(), args)
+		require.NoError(t, err)
+
+		var resp types.QueryMetaResponse
+		require.NoError(t, nc.Net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.Equal(t, metas[i], resp.Meta)
+	}
+
+	args := append([]string{objs[0].Meta.Creator, "missing"}, commonFlags...)
+	_, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdShowDeployment(), args)
+	require.ErrorContains(t, err, "not found")
+}
+
+func testListItems(t *testing.T, nc *network.Context, commonFlags []string, objs []*types.Deployment) {
+	for _, obj := range objs {
+		expected := make([]*types.ItemMeta, 0, len(obj.Dataset.Items))
+		for _, item := range obj.Dataset.Items {
+			expected = append(expected, item.Meta)
+		}
+
+		args := append([]string{obj.Meta.Creator, obj.Meta.Name}, commonFlags...)
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListItems(), args)
+		require.NoError(t, err)
+
+		var resp types.QueryItemsResponse
+		require.NoError(t, nc.Net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+		require.ElementsMatch(t, expected, resp.Items)
+	}
+
+	args := append([]string{objs[0].Meta.Creator, "missing"}, commonFlags...)
+	_, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListItems(), args)
+	require.ErrorContains(t, err, "not found")
+}
+
 func TestQueries(t *testing.T) {
 	nc, objs := network.SetupWithDeployments(t, keeper.NUM_DEPLOYMENT)
 	commonFlags := network.SetupQueryCommonFlags(t)
 
 	testListDeployments(t, nc, commonFlags, objs)
+	testFilterDeployments(t, nc, commonFlags, objs)
+	testSortDeployments(t, nc, commonFlags, objs)
+	testShowDeployment(t, nc, commonFlags, objs)
+	testListItems(t, nc, commonFlags, objs)
 }
@@ -25,6 +25,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateDeployment())
 	cmd.AddCommand(CmdUpdateDeployment())
 	cmd.AddCommand(CmdRemoveDeployment())
+	cmd.AddCommand(CmdPatchDeployment())
+	cmd.AddCommand(CmdRollbackDeployment())
+	cmd.AddCommand(CmdRenewDeployment())
+	cmd.AddCommand(CmdTransferDeployment())
+	cmd.AddCommand(CmdAcceptDeployment())
+	cmd.AddCommand(CmdAddCollaborator())
+	cmd.AddCommand(CmdRemoveCollaborator())
+	cmd.AddCommand(CmdGrantPublish())
+	cmd.AddCommand(CmdClaimDomain())
 
 	return cmd
 }
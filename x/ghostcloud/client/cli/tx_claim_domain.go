@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+func CmdClaimDomain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-domain name domain",
+		Short: "Claim a custom domain for a deployment",
+		Long: `Claim a custom domain for a deployment.
+
+The claim holds a challenge token, see 'query ghostcloud domain-claim'. The domain is assigned to the deployment
+once the token has been found in the DNS records of the domain and the claim has been verified by the module authority.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argName := args[0]
+			argDomain := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimDomainRequest{
+				Creator: clientCtx.GetFromAddress().String(),
+				Name:    argName,
+				Domain:  argDomain,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const collaboratorRolePrefix = "COLLABORATOR_ROLE_"
+
+// parseCollaboratorRole parses a collaborator role name, e.g., `editor` or `admin`.
+func parseCollaboratorRole(role string) (types.CollaboratorRole, error) {
+	value, ok := types.CollaboratorRole_value[collaboratorRolePrefix+strings.ToUpper(role)]
+	if !ok || value == int32(types.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED) {
+		return types.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED, fmt.Errorf("invalid collaborator role: %s, valid roles are: editor, admin", role)
+	}
+	return types.CollaboratorRole(value), nil
+}
+
+func CmdAddCollaborator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-collaborator name address role",
+		Short: "Grant a role on a deployment to an address (roles: editor, admin)",
+		Long: `Grant a role on a deployment to an address, or change the role of an existing collaborator.
+
+Editors may update and patch the deployment. Admins may also add and remove collaborators.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argName := args[0]
+			argAddress := args[1]
+			argRole, err := parseCollaboratorRole(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			creator, err := getCreator(cmd, signer)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddCollaboratorRequest{
+				Signer:  signer,
+				Creator: creator,
+				Name:    argName,
+				Address: argAddress,
+				Role:    argRole,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addCreatorFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveCollaborator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-collaborator name address",
+		Short: "Revoke the role of a collaborator on a deployment",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argName := args[0]
+			argAddress := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			creator, err := getCreator(cmd, signer)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveCollaboratorRequest{
+				Signer:  signer,
+				Creator: creator,
+				Name:    argName,
+				Address: argAddress,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addCreatorFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,100 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/client/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runCollaboratorTxTest(t *testing.T, nc *network.Context, cmd *cobra.Command, tc *network.TxTestCase) {
+	t.Run(tc.Name, func(t *testing.T) {
+		require.NoError(t, nc.Net.WaitForNextBlock())
+
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cmd, tc.Args)
+		if tc.Err == nil {
+			require.NoError(t, err)
+
+			var resp sdk.TxResponse
+			require.NoError(t, nc.Ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.NoError(t, clitestutil.CheckTxCode(nc.Net, nc.Ctx, resp.TxHash, tc.Code))
+		} else {
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.Err.Error())
+		}
+	})
+}
+
+func TestCollaborators(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+	creator := nc.Val.Address.String()
+	collaborator := sample.AccAddress()
+
+	clihelper.CreateDeployment(t, nc, 67890, commonFlags)
+
+	addTests := []network.TxTestCase{
+		{
+			Name: "add editor",
+			Args: append([]string{"67890", collaborator, "editor"}, commonFlags...),
+		},
+		{
+			Name: "promote to admin",
+			Args: append([]string{"67890", collaborator, "admin"}, commonFlags...),
+		},
+		{
+			Name: "creator as collaborator",
+			Args: append([]string{"67890", creator, "editor"}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "non-existing deployment",
+			Args: append([]string{"67891", collaborator, "editor"}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "invalid role",
+			Args: append([]string{"67890", collaborator, "owner"}, commonFlags...),
+			Err:  fmt.Errorf("invalid collaborator role"),
+		},
+	}
+	for _, tc := range addTests {
+		tc := tc
+		runCollaboratorTxTest(t, nc, cli.CmdAddCollaborator(), &tc)
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListCollaborators(), append([]string{creator, "67890"}, network.SetupQueryCommonFlags(t)...))
+	require.NoError(t, err)
+	require.Contains(t, out.String(), collaborator)
+	require.Contains(t, out.String(), "COLLABORATOR_ROLE_ADMIN")
+
+	removeTests := []network.TxTestCase{
+		{
+			Name: "remove",
+			Args: append([]string{"67890", collaborator}, commonFlags...),
+		},
+		{
+			Name: "not a collaborator",
+			Args: append([]string{"67890", collaborator}, commonFlags...),
+			Code: 38,
+		},
+		{
+			Name: "invalid address",
+			Args: append([]string{"67890", "invalid"}, commonFlags...),
+			Err:  fmt.Errorf("invalid collaborator address"),
+		},
+	}
+	for _, tc := range removeTests {
+		tc := tc
+		runCollaboratorTxTest(t, nc, cli.CmdRemoveCollaborator(), &tc)
+	}
+}
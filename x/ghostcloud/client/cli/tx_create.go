@@ -9,7 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func CmdCreateDeployment() *cobra.Command {
@@ -23,6 +23,10 @@ func CmdCreateDeployment() *cobra.Command {
 			argWebsitePayload := args[1]
 			argDescription := cmd.Flag(FlagDescription).Value.String()
 			argDomain := cmd.Flag(FlagDomain).Value.String()
+			argExpiresAt, err := cmd.Flags().GetInt64(FlagExpiresAt)
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -38,18 +42,19 @@ func CmdCreateDeployment() *cobra.Command {
 				return fmt.Errorf("unable to create payload: %v", err)
 			}
 
-			msg := &types.MsgCreateDeploymentRequest{
-				Meta:    createMeta(argName, argDescription, argDomain, clientCtx.GetFromAddress().String()),
-				Payload: payload,
-			}
-			if err := msg.ValidateBasic(); err != nil {
-				return err
-			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			meta := createMeta(argName, argDescription, argDomain, clientCtx.GetFromAddress().String())
+			meta.ExpiresAtHeight = argExpiresAt
+			return broadcastPayload(cmd, clientCtx, meta, payload, false, func(payload *types.Payload) sdk.Msg {
+				return &types.MsgCreateDeploymentRequest{
+					Meta:    meta,
+					Payload: payload,
+				}
+			})
 		},
 	}
 
 	addCreateFlags(cmd)
+	addChunkSizeFlag(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
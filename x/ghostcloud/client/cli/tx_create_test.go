@@ -3,11 +3,13 @@ package cli_test
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"ghostcloud/testutil/network"
 	"ghostcloud/testutil/sample"
 	"ghostcloud/x/ghostcloud/client/cli"
+	"ghostcloud/x/ghostcloud/types"
 
 	"github.com/stretchr/testify/require"
 
@@ -44,6 +46,10 @@ func TestCreateDeployment(t *testing.T) {
 	testCreateInvalidDatasetPath(t, nc, commonFlags)
 	testCreateInvalidArchivePath(t, nc, commonFlags)
 	testCreateNoIndex(t, nc, commonFlags)
+	testCreateChunkedDataset(t, nc, commonFlags)
+	testCreateChunkedArchive(t, nc, commonFlags)
+	testCreateNestedDataset(t, nc, commonFlags)
+	testCreateValidTarballs(t, nc, commonFlags)
 }
 
 func testCreateValidDataset(t *testing.T, nc *network.Context, commonFlags []string) {
@@ -97,3 +103,90 @@ func testCreateNoIndex(t *testing.T, nc *network.Context, commonFlags []string)
 		Err:  fmt.Errorf("website archive does not contain `index.html` at its root"),
 	})
 }
+
+func testCreateChunkedDataset(t *testing.T, nc *network.Context, commonFlags []string) {
+	data, err := sample.CreateTempDataset()
+	require.NoError(t, err)
+	defer os.RemoveAll(data)
+
+	runCreateTxTest(t, nc, &network.TxTestCase{
+		Name: "chunked_d",
+		Args: append([]string{data, fmt.Sprintf("--%s=16", cli.FlagChunkSize)}, commonFlags...),
+	})
+}
+
+func testCreateChunkedArchive(t *testing.T, nc *network.Context, commonFlags []string) {
+	data, err := sample.CreateTempArchive("index.html", sample.HelloWorldHTMLBody)
+	require.NoError(t, err)
+	defer data.Close()
+	defer os.Remove(data.Name())
+
+	runCreateTxTest(t, nc, &network.TxTestCase{
+		Name: "chunked_a",
+		Args: append([]string{data.Name(), fmt.Sprintf("--%s=16", cli.FlagChunkSize)}, commonFlags...),
+	})
+}
+
+func testCreateNestedDataset(t *testing.T, nc *network.Context, commonFlags []string) {
+	data := t.TempDir()
+	files := map[string]string{
+		"index.html":    sample.HelloWorldHTMLBody,
+		"assets/app.js": "assets",
+		"lib/app.js":    "lib",
+	}
+	for path, content := range files {
+		localPath := filepath.Join(data, filepath.FromSlash(path))
+		require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0o700))
+		require.NoError(t, os.WriteFile(localPath, []byte(content), 0o600))
+	}
+
+	runCreateTxTest(t, nc, &network.TxTestCase{
+		Name: "nested_d",
+		Args: append([]string{data}, commonFlags...),
+	})
+
+	// Files are recorded relative to the folder, using forward slashes
+	args := append([]string{nc.Val.Address.String(), "nested_d"}, network.SetupQueryCommonFlags(t)...)
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListItems(), args)
+	require.NoError(t, err)
+
+	var resp types.QueryItemsResponse
+	require.NoError(t, nc.Net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	paths := make([]string, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		paths = append(paths, item.Path)
+	}
+	require.Equal(t, []string{"assets/app.js", "index.html", "lib/app.js"}, paths)
+}
+
+func testCreateValidTarballs(t *testing.T, nc *network.Context, commonFlags []string) {
+	dir := t.TempDir()
+	tests := []struct {
+		name        string
+		file        string
+		archiveType types.ArchiveType
+	}{
+		{name: "valid_tgz", file: "site.tar.gz", archiveType: types.ArchiveType_TarGz},
+		{name: "valid_tzst", file: "site.tar.zst", archiveType: types.ArchiveType_TarZstd},
+	}
+	for _, tc := range tests {
+		path := filepath.Join(dir, tc.file)
+		content := sample.CreateTarballWithFiles(tc.archiveType, sample.ArchiveFile{Name: "./index.html", Body: sample.HelloWorldHTMLBody})
+		require.NoError(t, os.WriteFile(path, content, 0o600))
+
+		runCreateTxTest(t, nc, &network.TxTestCase{
+			Name: tc.name,
+			Args: append([]string{path}, commonFlags...),
+		})
+	}
+
+	// Tarballs are checked for an `index.html` file before being sent
+	path := filepath.Join(dir, "no_index.tgz")
+	content := sample.CreateTarballWithFiles(types.ArchiveType_TarGz, sample.ArchiveFile{Name: "foobar.html", Body: sample.HelloWorldHTMLBody})
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	runCreateTxTest(t, nc, &network.TxTestCase{
+		Name: "no_index_t",
+		Args: append([]string{path}, commonFlags...),
+		Err:  fmt.Errorf("website archive does not contain `index.html` at its root"),
+	})
+}
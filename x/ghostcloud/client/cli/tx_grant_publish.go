@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+const (
+	FlagNames          = "names"
+	FlagMaxPayloadSize = "max-payload-size"
+	FlagMsgTypes       = "msg-types"
+	FlagExpiration     = "expiration"
+)
+
+// publishMsgTypes maps the message names accepted by the msg-types flag to their type URL.
+var publishMsgTypes = map[string]string{
+	"create": sdk.MsgTypeURL(&types.MsgCreateDeploymentRequest{}),
+	"update": sdk.MsgTypeURL(&types.MsgUpdateDeploymentRequest{}),
+	"patch":  sdk.MsgTypeURL(&types.MsgPatchDeploymentRequest{}),
+}
+
+func CmdGrantPublish() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-publish grantee",
+		Short: "Allow an address to publish your deployments through x/authz",
+		Long: `Allow an address to publish your deployments through x/authz, e.g., a CI bot.
+
+The grants apply to every deployment the granter may publish, including those it collaborates on,
+unless restricted to the deployments of a single creator. One grant is created for each message
+type. The grantee publishes by wrapping the messages in "tx authz exec".`,
+		Example: "grant-publish gc1... --creator gc1... --names mysite,docs --max-payload-size 1048576 --msg-types update,patch",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %v", err)
+			}
+
+			creator, err := cmd.Flags().GetString(FlagCreator)
+			if err != nil {
+				return err
+			}
+			names, err := cmd.Flags().GetStringSlice(FlagNames)
+			if err != nil {
+				return err
+			}
+			maxPayloadSize, err := cmd.Flags().GetInt64(FlagMaxPayloadSize)
+			if err != nil {
+				return err
+			}
+			msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+			if err != nil {
+				return err
+			}
+			expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var expire *time.Time
+			if expiration > 0 {
+				t := time.Unix(expiration, 0)
+				expire = &t
+			}
+
+			msgs := make([]sdk.Msg, 0, len(msgTypes))
+			for _, msgType := range msgTypes {
+				typeURL, ok := publishMsgTypes[msgType]
+				if !ok {
+					return fmt.Errorf("invalid message type: %s, valid types are: create, update, patch", msgType)
+				}
+
+				msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, types.NewPublishAuthorization(typeURL, creator, names, maxPayloadSize), expire)
+				if err != nil {
+					return err
+				}
+				if err := msg.ValidateBasic(); err != nil {
+					return err
+				}
+				msgs = append(msgs, msg)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msgs...)
+		},
+	}
+
+	cmd.Flags().String(FlagCreator, "", "Creator of the deployments the grantee may publish (default: any)")
+	cmd.Flags().StringSlice(FlagNames, nil, "Names of the deployments the grantee may publish (default: any)")
+	cmd.Flags().Int64(FlagMaxPayloadSize, 0, "Maximum payload size, in bytes, of each message (0 = no limit)")
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{"create", "update", "patch"}, "Messages the grantee may send (options: create, update, patch)")
+	cmd.Flags().Int64(FlagExpiration, 0, "Expiration of the grants, as a unix timestamp (0 = never)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,128 @@
+package cli_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/client/cli"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzcli "github.com/cosmos/cosmos-sdk/x/authz/client/cli"
+)
+
+func TestGrantPublish(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+	grantee := sample.AccAddress()
+
+	tests := []network.TxTestCase{
+		{
+			Name: "grant publish",
+			Args: append([]string{grantee, "--creator", nc.Val.Address.String(), "--names", "mysite,docs", "--max-payload-size", "1024", "--msg-types", "update,patch"}, commonFlags...),
+		},
+		{
+			Name: "invalid creator",
+			Args: append([]string{grantee, "--creator", "invalid"}, commonFlags...),
+			Err:  fmt.Errorf("invalid creator address"),
+		},
+		{
+			Name: "invalid grantee",
+			Args: append([]string{"invalid"}, commonFlags...),
+			Err:  fmt.Errorf("invalid grantee address"),
+		},
+		{
+			Name: "invalid message type",
+			Args: append([]string{grantee, "--msg-types", "remove"}, commonFlags...),
+			Err:  fmt.Errorf("invalid message type"),
+		},
+		{
+			Name: "negative max payload size",
+			Args: append([]string{grantee, "--max-payload-size", "-1"}, commonFlags...),
+			Err:  fmt.Errorf("max payload size should not be negative"),
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		runCollaboratorTxTest(t, nc, cli.CmdGrantPublish(), &tc)
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, authzcli.GetCmdQueryGrants(), append([]string{nc.Val.Address.String(), grantee}, network.SetupQueryCommonFlags(t)...))
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "PublishAuthorization")
+	require.Contains(t, out.String(), "MsgUpdateDeploymentRequest")
+	require.Contains(t, out.String(), "MsgPatchDeploymentRequest")
+	require.NotContains(t, out.String(), "MsgCreateDeploymentRequest")
+	require.Contains(t, out.String(), "mysite")
+	require.Contains(t, out.String(), nc.Val.Address.String())
+}
+
+func TestExecPublish(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+	creator := nc.Val.Address.String()
+
+	// Create and fund the grantee account
+	record, _, err := nc.Ctx.Keyring.NewMnemonic("publisher", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	require.NoError(t, err)
+	grantee, err := record.GetAddress()
+	require.NoError(t, err)
+	_, err = clitestutil.MsgSendExec(nc.Ctx, nc.Val.Address, grantee, sdk.NewCoins(sdk.NewCoin(nc.Net.Config.BondDenom, sdkmath.NewInt(1000000))), commonFlags[1:]...)
+	require.NoError(t, err)
+	require.NoError(t, nc.Net.WaitForNextBlock())
+
+	clihelper.CreateDeployment(t, nc, 24680, commonFlags)
+	clihelper.CreateDeployment(t, nc, 24681, commonFlags)
+	require.NoError(t, nc.Net.WaitForNextBlock())
+
+	_, err = clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdGrantPublish(), append([]string{grantee.String(), "--names", "24680", "--msg-types", "update"}, commonFlags...))
+	require.NoError(t, err)
+	require.NoError(t, nc.Net.WaitForNextBlock())
+
+	granteeFlags := append([]string{fmt.Sprintf(network.FlagPattern, flags.FlagFrom, grantee.String())}, commonFlags[1:]...)
+	generateOnly := []string{fmt.Sprintf(network.FlagPattern, flags.FlagFrom, creator), fmt.Sprintf("--%s=true", flags.FlagGenerateOnly)}
+
+	tests := []network.TxTestCase{
+		{
+			Name: "granted deployment",
+			Args: []string{"24680", clihelper.NewDescription, clihelper.NewDomain},
+		},
+		{
+			Name: "deployment not in the grant",
+			Args: []string{"24681", clihelper.NewDescription, clihelper.NewDomain},
+			Code: 4,
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdUpdateDeployment(), append(tc.Args, generateOnly...))
+			require.NoError(t, err)
+
+			txFile, err := os.CreateTemp(t.TempDir(), "tx-*.json")
+			require.NoError(t, err)
+			_, err = txFile.Write(out.Bytes())
+			require.NoError(t, err)
+			require.NoError(t, txFile.Close())
+
+			tc.Args = append([]string{txFile.Name()}, granteeFlags...)
+			runCollaboratorTxTest(t, nc, authzcli.NewCmdExecAuthorization(), &tc)
+		})
+	}
+
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdShowDeploymentByDomain(), append([]string{clihelper.NewDomain}, network.SetupQueryCommonFlags(t)...))
+	require.NoError(t, err)
+	require.Contains(t, out.String(), "24680")
+	require.Contains(t, out.String(), clihelper.NewDescription)
+}
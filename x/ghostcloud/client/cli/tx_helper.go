@@ -1,10 +1,7 @@
 package cli
 
 import (
-	"archive/zip"
-	"bytes"
 	"fmt"
-	"log"
 	"os"
 	"path/filepath"
 	"strings"
@@ -17,15 +14,40 @@ import (
 const (
 	FlagDescription    = "description"
 	FlagDomain         = "domain"
+	FlagExpiresAt      = "expires-at-height"
 	FlagWebsitePayload = "website-payload"
-	zipArchiveSuffix   = ".zip"
+	FlagCreator        = "creator"
 	FlagDummyDefault   = "[GHOSTCLOUD]"
 )
 
+// archiveSuffixes maps the file suffixes of the supported website archives to their type.
+var archiveSuffixes = []struct {
+	suffix      string
+	archiveType types.ArchiveType
+}{
+	{".zip", types.ArchiveType_Zip},
+	{".tar.gz", types.ArchiveType_TarGz},
+	{".tgz", types.ArchiveType_TarGz},
+	{".tar.zst", types.ArchiveType_TarZstd},
+	{".tzst", types.ArchiveType_TarZstd},
+}
+
+// detectArchiveType returns the type of the website archive at path, detected from its suffix. It returns false if the
+// path is not an archive.
+func detectArchiveType(path string) (types.ArchiveType, bool) {
+	for _, s := range archiveSuffixes {
+		if strings.HasSuffix(strings.ToLower(path), s.suffix) {
+			return s.archiveType, true
+		}
+	}
+	return 0, false
+}
+
 func addCreateFlags(cmd *cobra.Command) {
 	f := cmd.Flags()
 	f.String(FlagDescription, FlagDummyDefault, "Description of the deployment")
 	f.String(FlagDomain, FlagDummyDefault, "Custom domain of the deployment")
+	f.Int64(FlagExpiresAt, 0, "Block height at the end of which the deployment is removed (0 = never)")
 }
 
 func addUpdateFlags(cmd *cobra.Command) {
@@ -33,6 +55,22 @@ func addUpdateFlags(cmd *cobra.Command) {
 	f.String(FlagWebsitePayload, FlagDummyDefault, "Path to the website payload")
 }
 
+func addCreatorFlag(cmd *cobra.Command) {
+	cmd.Flags().String(FlagCreator, "", "Creator of the deployment, when signing as one of its collaborators (default: the signer)")
+}
+
+// getCreator returns the creator of the deployment set by the creator flag, defaulting to the signer.
+func getCreator(cmd *cobra.Command, signer string) (string, error) {
+	creator, err := cmd.Flags().GetString(FlagCreator)
+	if err != nil {
+		return "", err
+	}
+	if creator == "" {
+		return signer, nil
+	}
+	return creator, nil
+}
+
 // isDir Check if a path is a directory. Panics if the path does not exist.
 func isDir(path string) (bool, error) {
 	info, err := os.Stat(path)
@@ -42,28 +80,29 @@ func isDir(path string) (bool, error) {
 	return info.IsDir(), nil
 }
 
-func loadArchive(path string) ([]byte, error) {
+func loadArchive(path string, archiveType types.ArchiveType) ([]byte, error) {
 	// Read website archive
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read website archive: %v", err)
 	}
 
-	// See zip.NewReader documentation for more details about why this is needed
-	err = os.Setenv("GODEBUG", "zipinsecurepath=0")
-	if err != nil {
-		return nil, fmt.Errorf("unable to set GODEBUG to zipinsecurepath=0: %v", err)
+	if archiveType == types.ArchiveType_Zip {
+		// See zip.NewReader documentation for more details about why this is needed
+		err = os.Setenv("GODEBUG", "zipinsecurepath=0")
+		if err != nil {
+			return nil, fmt.Errorf("unable to set GODEBUG to zipinsecurepath=0: %v", err)
+		}
 	}
 
-	r := bytes.NewReader(data)
-	zipReader, err := zip.NewReader(r, int64(len(data)))
+	dataset, err := types.DatasetFromArchive(&types.Archive{Type: archiveType, Content: data})
 	if err != nil {
-		return nil, fmt.Errorf("unable to create website archive reader: %v", err)
+		return nil, fmt.Errorf("unable to read website archive: %v", err)
 	}
 
 	found := false
-	for _, f := range zipReader.File {
-		if f.Name == "index.html" {
+	for _, item := range dataset.GetItems() {
+		if item.GetMeta().GetPath() == "index.html" {
 			found = true
 		}
 	}
@@ -75,20 +114,36 @@ func loadArchive(path string) ([]byte, error) {
 	return data, nil
 }
 
-func loadFolder(path string) []*types.Item {
+// loadFolder reads the files of a folder. Their paths are relative to the folder and use forward slashes.
+func loadFolder(root string) ([]*types.Item, error) {
 	// Walk through the directory and process each file
 	var items []*types.Item
-	werr := filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+	paths := make(map[string]struct{})
+	werr := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
 		if info.IsDir() {
 			return nil
 		}
+
+		rel, rerr := filepath.Rel(root, path)
+		if rerr != nil {
+			return rerr
+		}
+		itemPath := types.NormalizePath(filepath.ToSlash(rel))
+		if _, ok := paths[itemPath]; ok {
+			return fmt.Errorf(types.DuplicatePath, itemPath)
+		}
+		paths[itemPath] = struct{}{}
+
 		content, rerr := os.ReadFile(path)
 		if rerr != nil {
-			log.Fatalf("unable to read file: %v", rerr)
+			return fmt.Errorf("unable to read file: %v", rerr)
 		}
 		items = append(items, &types.Item{
 			Meta: &types.ItemMeta{
-				Path: info.Name(),
+				Path: itemPath,
 			},
 			Content: &types.ItemContent{
 				Content: content,
@@ -98,41 +153,44 @@ func loadFolder(path string) []*types.Item {
 		return nil
 	})
 	if werr != nil {
-		log.Fatalf("unable to walk through website folder: %v", werr)
+		return nil, fmt.Errorf("unable to walk through website folder: %v", werr)
 	}
 
-	return items
+	return items, nil
 }
 
-func createArchivePayload(path string) (*types.Payload, error) {
-	data, err := loadArchive(path)
+func createArchivePayload(path string, archiveType types.ArchiveType) (*types.Payload, error) {
+	data, err := loadArchive(path, archiveType)
 	if err != nil {
 		return nil, fmt.Errorf("unable to load archive: %v", err)
 	}
 	return &types.Payload{
 		PayloadOption: &types.Payload_Archive{
 			Archive: &types.Archive{
-				Type:    types.ArchiveType_Zip,
+				Type:    archiveType,
 				Content: data,
 			},
 		},
 	}, nil
 }
 
-func createDatasetPayload(path string) *types.Payload {
-	data := loadFolder(path)
+func createDatasetPayload(path string) (*types.Payload, error) {
+	data, err := loadFolder(path)
+	if err != nil {
+		return nil, err
+	}
 	return &types.Payload{
 		PayloadOption: &types.Payload_Dataset{
 			Dataset: &types.Dataset{
 				Items: data,
 			},
 		},
-	}
+	}, nil
 }
 
 func createPayload(path string) (*types.Payload, error) {
-	if strings.HasSuffix(path, zipArchiveSuffix) {
-		payload, err := createArchivePayload(path)
+	if archiveType, ok := detectArchiveType(path); ok {
+		payload, err := createArchivePayload(path, archiveType)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create archive payload: %v", err)
 		}
@@ -140,7 +198,11 @@ func createPayload(path string) (*types.Payload, error) {
 	} else if b, err := isDir(path); err != nil {
 		return nil, fmt.Errorf("unable to process path: %v", err)
 	} else if b {
-		return createDatasetPayload(path), nil
+		payload, err := createDatasetPayload(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create dataset payload: %v", err)
+		}
+		return payload, nil
 	}
 
 	return nil, nil
@@ -0,0 +1,145 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+const (
+	FlagSet             = "set"
+	FlagDelete          = "delete"
+	FlagContentType     = "content-type"
+	FlagCacheControl    = "cache-control"
+	FlagContentEncoding = "content-encoding"
+)
+
+// parseSetFlag parses a `local-file[:path]` value. The path defaults to the local file name.
+func parseSetFlag(value string) (localPath string, path string) {
+	if i := strings.LastIndex(value, ":"); i > 0 {
+		return value[:i], value[i+1:]
+	}
+	return value, filepath.Base(value)
+}
+
+// createPatchDataset reads the files to add or replace. The content type and headers of the headers meta, if any, are
+// applied to every file.
+func createPatchDataset(values []string, headers types.ItemMeta) (*types.Dataset, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	items := make([]*types.Item, 0, len(values))
+	for _, value := range values {
+		localPath, path := parseSetFlag(value)
+		content, err := os.ReadFile(localPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file: %v", err)
+		}
+		items = append(items, &types.Item{
+			Meta: &types.ItemMeta{
+				Path:            path,
+				ContentType:     headers.ContentType,
+				CacheControl:    headers.CacheControl,
+				ContentEncoding: headers.ContentEncoding,
+			},
+			Content: &types.ItemContent{Content: content},
+		})
+	}
+
+	return &types.Dataset{Items: items}, nil
+}
+
+func CmdPatchDeployment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "patch name",
+		Short: "Add, replace or delete individual files of a deployment",
+		Long: `Add, replace or delete individual files of a deployment.
+
+Use --set local-file[:path] to add or replace a file; the path defaults to the local file name.
+Use --delete path to delete a file. Both flags can be repeated.
+Use --content-type, --cache-control and --content-encoding to set the content type and HTTP headers served with the
+files added or replaced by the patch; the content type is detected from the path and the content by default.`,
+		Example: "patch mysite --set ./dist/style.css:css/style.css --delete old.js",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argName := args[0]
+
+			setValues, err := cmd.Flags().GetStringArray(FlagSet)
+			if err != nil {
+				return err
+			}
+			deletePaths, err := cmd.Flags().GetStringArray(FlagDelete)
+			if err != nil {
+				return err
+			}
+			if len(setValues) == 0 && len(deletePaths) == 0 {
+				return fmt.Errorf("at least one of --%s or --%s is required", FlagSet, FlagDelete)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var headers types.ItemMeta
+			if headers.ContentType, err = cmd.Flags().GetString(FlagContentType); err != nil {
+				return err
+			}
+			if headers.CacheControl, err = cmd.Flags().GetString(FlagCacheControl); err != nil {
+				return err
+			}
+			if headers.ContentEncoding, err = cmd.Flags().GetString(FlagContentEncoding); err != nil {
+				return err
+			}
+			if err := types.ValidateItemMeta(&headers); err != nil {
+				return err
+			}
+
+			upsert, err := createPatchDataset(setValues, headers)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			creator, err := getCreator(cmd, signer)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPatchDeploymentRequest{
+				Creator: creator,
+				Name:    argName,
+				Upsert:  upsert,
+				Delete:  deletePaths,
+			}
+			if creator != signer {
+				msg.Signer = signer
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringArray(FlagSet, nil, "File to add or replace, as local-file[:path]")
+	cmd.Flags().StringArray(FlagDelete, nil, "Path of a file to delete")
+	cmd.Flags().String(FlagContentType, "", "Content type of the files to add or replace (default: detected)")
+	cmd.Flags().String(FlagCacheControl, "", "Cache-Control header served with the files to add or replace")
+	cmd.Flags().String(FlagContentEncoding, "", "Content-Encoding header served with the files to add or replace, e.g., gzip")
+	addCreatorFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
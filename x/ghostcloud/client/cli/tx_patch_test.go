@@ -0,0 +1,104 @@
+package cli_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/x/ghostcloud/client/cli"
+
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runPatchTxTest(t *testing.T, nc *network.Context, tc *network.TxTestCase) {
+	t.Run(tc.Name, func(t *testing.T) {
+		require.NoError(t, nc.Net.WaitForNextBlock())
+
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdPatchDeployment(), tc.Args)
+		if tc.Err == nil {
+			require.NoError(t, err)
+
+			var resp sdk.TxResponse
+			require.NoError(t, nc.Ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.NoError(t, clitestutil.CheckTxCode(nc.Net, nc.Ctx, resp.TxHash, tc.Code))
+		} else {
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.Err.Error())
+		}
+	})
+}
+
+func TestPatchDeployment(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+
+	dir := t.TempDir()
+	newFile := filepath.Join(dir, "new.html")
+	require.NoError(t, os.WriteFile(newFile, []byte(clihelper.NewContent), 0o600))
+
+	clihelper.CreateDeployment(t, nc, 23456, commonFlags)
+
+	tests := []network.TxTestCase{
+		{
+			Name: "add file",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagSet, newFile)}, commonFlags...),
+		},
+		{
+			Name: "replace and rename",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagSet, newFile+":"+clihelper.IndexHTML)}, commonFlags...),
+		},
+		{
+			Name: "add file with headers",
+			Args: append([]string{
+				"23456",
+				fmt.Sprintf(network.FlagPattern, cli.FlagSet, newFile+":feed"),
+				fmt.Sprintf(network.FlagPattern, cli.FlagContentType, "application/rss+xml"),
+				fmt.Sprintf(network.FlagPattern, cli.FlagCacheControl, "no-cache"),
+			}, commonFlags...),
+		},
+		{
+			Name: "invalid content encoding",
+			Args: append([]string{
+				"23456",
+				fmt.Sprintf(network.FlagPattern, cli.FlagSet, newFile),
+				fmt.Sprintf(network.FlagPattern, cli.FlagContentEncoding, "rot13"),
+			}, commonFlags...),
+			Err: fmt.Errorf("invalid content encoding"),
+		},
+		{
+			Name: "delete file",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagDelete, "new.html")}, commonFlags...),
+		},
+		{
+			Name: "delete missing file",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagDelete, "new.html")}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "delete index.html",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagDelete, clihelper.IndexHTML)}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "nothing to patch",
+			Args: append([]string{"23456"}, commonFlags...),
+			Err:  fmt.Errorf("at least one of --%s or --%s is required", cli.FlagSet, cli.FlagDelete),
+		},
+		{
+			Name: "missing local file",
+			Args: append([]string{"23456", fmt.Sprintf(network.FlagPattern, cli.FlagSet, filepath.Join(dir, "missing"))}, commonFlags...),
+			Err:  fmt.Errorf("unable to read file"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		runPatchTxTest(t, nc, &tc)
+	}
+}
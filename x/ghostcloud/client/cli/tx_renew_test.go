@@ -0,0 +1,68 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/x/ghostcloud/client/cli"
+
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runRenewTxTest(t *testing.T, nc *network.Context, tc *network.TxTestCase) {
+	t.Run(tc.Name, func(t *testing.T) {
+		require.NoError(t, nc.Net.WaitForNextBlock())
+
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdRenewDeployment(), tc.Args)
+		if tc.Err == nil {
+			require.NoError(t, err)
+
+			var resp sdk.TxResponse
+			require.NoError(t, nc.Ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.NoError(t, clitestutil.CheckTxCode(nc.Net, nc.Ctx, resp.TxHash, tc.Code))
+		} else {
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.Err.Error())
+		}
+	})
+}
+
+func TestRenewDeployment(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+
+	clihelper.CreateDeployment(t, nc, 45678, append([]string{fmt.Sprintf("--%s=%d", cli.FlagExpiresAt, 100000)}, commonFlags...))
+	clihelper.CreateDeployment(t, nc, 45679, commonFlags)
+
+	tests := []network.TxTestCase{
+		{
+			Name: "renew",
+			Args: append([]string{"45678", "200000"}, commonFlags...),
+		},
+		{
+			Name: "expiry not extended",
+			Args: append([]string{"45678", "150000"}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "deployment does not expire",
+			Args: append([]string{"45679", "200000"}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "invalid expiry height",
+			Args: append([]string{"45678", "foo"}, commonFlags...),
+			Err:  fmt.Errorf("invalid expiry height"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		runRenewTxTest(t, nc, &tc)
+	}
+}
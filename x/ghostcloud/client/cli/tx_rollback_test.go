@@ -0,0 +1,72 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/x/ghostcloud/client/cli"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runRollbackTxTest(t *testing.T, nc *network.Context, tc *network.TxTestCase) {
+	t.Run(tc.Name, func(t *testing.T) {
+		require.NoError(t, nc.Net.WaitForNextBlock())
+
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdRollbackDeployment(), tc.Args)
+		if tc.Err == nil {
+			require.NoError(t, err)
+
+			var resp sdk.TxResponse
+			require.NoError(t, nc.Ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.NoError(t, clitestutil.CheckTxCode(nc.Net, nc.Ctx, resp.TxHash, tc.Code))
+		} else {
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.Err.Error())
+		}
+	})
+}
+
+func TestRollbackDeployment(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+
+	clihelper.CreateDeployment(t, nc, 34567, commonFlags)
+
+	tests := []network.TxTestCase{
+		{
+			Name: "rollback to the live revision",
+			Args: append([]string{"34567", "1"}, commonFlags...),
+		},
+		{
+			Name: "missing revision",
+			Args: append([]string{"34567", "3"}, commonFlags...),
+			Code: 38,
+		},
+		{
+			Name: "invalid revision",
+			Args: append([]string{"34567", "foo"}, commonFlags...),
+			Err:  fmt.Errorf("invalid revision"),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		runRollbackTxTest(t, nc, &tc)
+	}
+
+	require.NoError(t, nc.Net.WaitForNextBlock())
+	out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cli.CmdListRevisions(), []string{nc.Val.Address.String(), "34567", "--output=json"})
+	require.NoError(t, err)
+
+	var resp types.QueryRevisionsResponse
+	require.NoError(t, nc.Net.Config.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	require.Len(t, resp.Revisions, 2)
+	require.Equal(t, resp.Revisions[0].Hash, resp.Revisions[1].Hash)
+}
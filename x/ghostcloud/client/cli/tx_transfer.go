@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+func CmdTransferDeployment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer name recipient",
+		Short: "Offer a deployment to a new owner, or cancel the pending transfer by offering it to yourself",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argName := args[0]
+			argRecipient := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgTransferDeploymentRequest{
+				Creator:   clientCtx.GetFromAddress().String(),
+				Name:      argName,
+				Recipient: argRecipient,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptDeployment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-transfer creator name",
+		Short: "Accept the pending transfer of a deployment, escrowing its deposit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argCreator := args[0]
+			argName := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAcceptDeploymentRequest{
+				Recipient: clientCtx.GetFromAddress().String(),
+				Creator:   argCreator,
+				Name:      argName,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+
+	clihelper "ghostcloud/testutil/cli"
+	"ghostcloud/testutil/network"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/client/cli"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func runTransferTxTest(t *testing.T, nc *network.Context, cmd *cobra.Command, tc *network.TxTestCase) {
+	t.Run(tc.Name, func(t *testing.T) {
+		require.NoError(t, nc.Net.WaitForNextBlock())
+
+		out, err := clitestutil.ExecTestCLICmd(nc.Ctx, cmd, tc.Args)
+		if tc.Err == nil {
+			require.NoError(t, err)
+
+			var resp sdk.TxResponse
+			require.NoError(t, nc.Ctx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			require.NoError(t, clitestutil.CheckTxCode(nc.Net, nc.Ctx, resp.TxHash, tc.Code))
+		} else {
+			require.Error(t, err)
+			require.ErrorContains(t, err, tc.Err.Error())
+		}
+	})
+}
+
+func TestTransferDeployment(t *testing.T) {
+	nc := network.Setup(t)
+	commonFlags := network.SetupTxCommonFlags(t, nc)
+	creator := nc.Val.Address.String()
+
+	clihelper.CreateDeployment(t, nc, 56789, commonFlags)
+
+	transferTests := []network.TxTestCase{
+		{
+			Name: "transfer",
+			Args: append([]string{"56789", sample.AccAddress()}, commonFlags...),
+		},
+		{
+			Name: "cancel",
+			Args: append([]string{"56789", creator}, commonFlags...),
+		},
+		{
+			Name: "non-existing deployment",
+			Args: append([]string{"56790", sample.AccAddress()}, commonFlags...),
+			Code: 18,
+		},
+		{
+			Name: "invalid recipient",
+			Args: append([]string{"56789", "invalid"}, commonFlags...),
+			Err:  fmt.Errorf("invalid recipient address"),
+		},
+	}
+	for _, tc := range transferTests {
+		tc := tc
+		runTransferTxTest(t, nc, cli.CmdTransferDeployment(), &tc)
+	}
+
+	acceptTests := []network.TxTestCase{
+		{
+			Name: "no pending transfer",
+			Args: append([]string{creator, "56789"}, commonFlags...),
+			Code: 38,
+		},
+		{
+			Name: "invalid creator",
+			Args: append([]string{"invalid", "56789"}, commonFlags...),
+			Err:  fmt.Errorf("invalid creator address"),
+		},
+	}
+	for _, tc := range acceptTests {
+		tc := tc
+		runTransferTxTest(t, nc, cli.CmdAcceptDeployment(), &tc)
+	}
+}
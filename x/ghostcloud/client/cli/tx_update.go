@@ -9,7 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func CmdUpdateDeployment() *cobra.Command {
@@ -29,25 +29,37 @@ func CmdUpdateDeployment() *cobra.Command {
 				return err
 			}
 
-			msg := &types.MsgUpdateDeploymentRequest{
-				Meta: createMeta(argName, argDescription, argDomain, clientCtx.GetFromAddress().String()),
+			signer := clientCtx.GetFromAddress().String()
+			creator, err := getCreator(cmd, signer)
+			if err != nil {
+				return err
 			}
+
+			meta := createMeta(argName, argDescription, argDomain, creator)
+			var payload *types.Payload
 			if argWebsitePayload != FlagDummyDefault {
-				payload, err := createPayload(argWebsitePayload)
+				payload, err = createPayload(argWebsitePayload)
 				if err != nil {
 					return fmt.Errorf("unable to create payload: %v", err)
 				}
-				msg.Payload = payload
 			}
 
-			if err := msg.ValidateBasic(); err != nil {
-				return err
-			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return broadcastPayload(cmd, clientCtx, meta, payload, true, func(payload *types.Payload) sdk.Msg {
+				msg := &types.MsgUpdateDeploymentRequest{
+					Meta:    meta,
+					Payload: payload,
+				}
+				if creator != signer {
+					msg.Signer = signer
+				}
+				return msg
+			})
 		},
 	}
 
 	addUpdateFlags(cmd)
+	addCreatorFlag(cmd)
+	addChunkSizeFlag(cmd)
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -73,8 +73,8 @@ func setNewDescription(expected *types.Deployment) (*types.Deployment, string) {
 }
 
 func setNewDomain(expected *types.Deployment) (*types.Deployment, string) {
-	expected.Meta.Domain = clihelper.NewDomain
-	return expected, clihelper.NewDomain
+	expected.Meta.Domain = expected.Meta.Name + "." + clihelper.NewDomain
+	return expected, expected.Meta.Domain
 }
 
 func setNewPayload(expected *types.Deployment, newArchivePath string) (*types.Deployment, string) {
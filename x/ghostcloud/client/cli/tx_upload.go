@@ -0,0 +1,261 @@
+package cli
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const (
+	FlagChunkSize = "chunk-size"
+
+	// DefaultChunkSize keeps the upload transactions below the default CometBFT maximum transaction size (1MB)
+	DefaultChunkSize = 512 * 1024
+
+	uploadTxTimeout      = time.Minute
+	uploadTxPollInterval = 500 * time.Millisecond
+)
+
+func addChunkSizeFlag(cmd *cobra.Command) {
+	cmd.Flags().Int(FlagChunkSize, DefaultChunkSize, "Payloads larger than this size, in bytes, are uploaded in multiple transactions")
+}
+
+// broadcastPayload broadcasts the payload in a single transaction built by newMsg if it fits in a chunk, or
+// through an upload session otherwise.
+func broadcastPayload(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	meta *types.Meta,
+	payload *types.Payload,
+	update bool,
+	newMsg func(payload *types.Payload) sdk.Msg,
+) error {
+	chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+	if err != nil {
+		return err
+	}
+	if chunkSize <= 0 {
+		return fmt.Errorf("chunk size must be positive: %d", chunkSize)
+	}
+
+	if payload == nil || payload.Size() <= chunkSize {
+		msg := newMsg(payload)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+
+	// Upload sessions are owned by the creator of the deployment
+	if meta.GetCreator() != clientCtx.GetFromAddress().String() {
+		return fmt.Errorf("payloads larger than the chunk size can only be uploaded by the creator of the deployment")
+	}
+
+	dataset := payload.GetDataset()
+	if archive := payload.GetArchive(); archive != nil {
+		dataset, err = types.DatasetFromArchive(archive)
+		if err != nil {
+			return fmt.Errorf("unable to extract archive: %v", err)
+		}
+	}
+
+	return uploadDataset(clientCtx, cmd.Flags(), meta, dataset, update, chunkSize)
+}
+
+// uploadItemMetas returns the item metas of a dataset setting a content type or HTTP headers, sent when the upload
+// session is opened.
+func uploadItemMetas(dataset *types.Dataset) []*types.ItemMeta {
+	var metas []*types.ItemMeta
+	for _, item := range dataset.GetItems() {
+		meta := item.GetMeta()
+		if meta.GetContentType() == "" && meta.GetCacheControl() == "" && meta.GetContentEncoding() == "" {
+			continue
+		}
+		metas = append(metas, &types.ItemMeta{
+			Path:            meta.GetPath(),
+			ContentType:     meta.GetContentType(),
+			CacheControl:    meta.GetCacheControl(),
+			ContentEncoding: meta.GetContentEncoding(),
+		})
+	}
+	return metas
+}
+
+// splitChunks splits the files of a dataset in upload chunks of at most chunkSize bytes, grouped in batches of at
+// most chunkSize bytes. Each batch is sent in its own transaction.
+func splitChunks(creator string, sessionID uint64, dataset *types.Dataset, chunkSize int) [][]sdk.Msg {
+	var batches [][]sdk.Msg
+	var batch []sdk.Msg
+	var batchSize int
+
+	for _, item := range dataset.GetItems() {
+		content := item.GetContent().GetContent()
+		for offset := 0; offset == 0 || offset < len(content); offset += chunkSize {
+			end := offset + chunkSize
+			if end > len(content) {
+				end = len(content)
+			}
+			if batchSize+end-offset > chunkSize && len(batch) > 0 {
+				batches = append(batches, batch)
+				batch, batchSize = nil, 0
+			}
+			batch = append(batch, &types.MsgUploadChunkRequest{
+				Creator:   creator,
+				SessionId: sessionID,
+				Path:      item.GetMeta().GetPath(),
+				Data:      content[offset:end],
+			})
+			batchSize += end - offset
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	return batches
+}
+
+// uploadDataset creates or updates a deployment through an upload session. Each transaction is confirmed before the
+// next one is sent.
+func uploadDataset(
+	clientCtx client.Context,
+	flagSet *pflag.FlagSet,
+	meta *types.Meta,
+	dataset *types.Dataset,
+	update bool,
+	chunkSize int,
+) error {
+	if clientCtx.GenerateOnly || clientCtx.Simulate {
+		return errors.New("payloads larger than the chunk size cannot be generated offline or simulated")
+	}
+
+	begin := &types.MsgBeginUploadRequest{Meta: meta, Update: update, Items: uploadItemMetas(dataset)}
+	if err := begin.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if !clientCtx.SkipConfirm {
+		prompt := fmt.Sprintf("upload %d files (%d bytes) in multiple transactions", len(dataset.GetItems()), dataset.Size())
+		ok, err := input.GetConfirmation(prompt, bufio.NewReader(os.Stdin), os.Stderr)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "cancelled upload")
+			return err
+		}
+	}
+
+	res, err := broadcastAndWait(clientCtx, flagSet, begin)
+	if err != nil {
+		return fmt.Errorf("unable to begin upload: %v", err)
+	}
+	var beginResp types.MsgBeginUploadResponse
+	if err := unpackMsgResponse(res, &beginResp); err != nil {
+		return err
+	}
+
+	batches := splitChunks(meta.Creator, beginResp.SessionId, dataset, chunkSize)
+	for i, batch := range batches {
+		if _, err := broadcastAndWait(clientCtx, flagSet, batch...); err != nil {
+			return fmt.Errorf("unable to upload chunk batch %d/%d of session %d: %v", i+1, len(batches), beginResp.SessionId, err)
+		}
+	}
+
+	res, err = broadcastAndWait(clientCtx, flagSet, &types.MsgCommitUploadRequest{
+		Creator:   meta.Creator,
+		SessionId: beginResp.SessionId,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to commit upload session %d: %v", beginResp.SessionId, err)
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// broadcastAndWait signs and broadcasts a transaction, then waits until it is included in a block.
+func broadcastAndWait(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := tx.NewFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return nil, err
+	}
+	txf, err = txf.Prepare(clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(txf, clientCtx.GetFromName(), builder, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	hash := res.TxHash
+	deadline := time.Now().Add(uploadTxTimeout)
+	for {
+		res, err = authtx.QueryTx(clientCtx, hash)
+		if err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("transaction not included in a block after %s: %v", uploadTxTimeout, err)
+		}
+		time.Sleep(uploadTxPollInterval)
+	}
+	if res.Code != 0 {
+		return res, fmt.Errorf("transaction %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	return res, nil
+}
+
+// unpackMsgResponse decodes the response of the first message of a transaction.
+func unpackMsgResponse(res *sdk.TxResponse, resp interface{ Unmarshal([]byte) error }) error {
+	data, err := hex.DecodeString(res.Data)
+	if err != nil {
+		return fmt.Errorf("unable to decode transaction data: %v", err)
+	}
+
+	var msgData sdk.TxMsgData
+	if err := msgData.Unmarshal(data); err != nil {
+		return fmt.Errorf("unable to decode transaction data: %v", err)
+	}
+	if len(msgData.MsgResponses) == 0 {
+		return fmt.Errorf("transaction %s has no message response", res.TxHash)
+	}
+
+	return resp.Unmarshal(msgData.MsgResponses[0].Value)
+}
@@ -0,0 +1,262 @@
+package gateway
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const IndexHTML = "index.html"
+
+// IDPrefix is the path prefix of the requests routed by stable deployment identifier.
+const IDPrefix = "/id/"
+
+// Handler serves deployments as websites. Requests are routed using the
+// `/<creator>/<name>/<path>` scheme and resolved through the `Content` query.
+// Requests using the `/id/<id>/<path>` scheme are resolved through the
+// `DeploymentById` query, so their URLs survive ownership transfers.
+// When domain routing is enabled, requests whose Host header matches the
+// verified domain of a deployment are served from the root of that deployment.
+type Handler struct {
+	queryClient   types.QueryClient
+	domainRouting bool
+}
+
+// Option configures a gateway handler.
+type Option func(*Handler)
+
+// WithDomainRouting enables routing requests using their Host header.
+func WithDomainRouting() Option {
+	return func(h *Handler) {
+		h.domainRouting = true
+	}
+}
+
+// NewHandler returns a new gateway handler using the provided query client.
+func NewHandler(queryClient types.QueryClient, opts ...Option) *Handler {
+	h := &Handler{queryClient: queryClient}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// hostname returns the host of a request without its port.
+func hostname(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.Host)
+	if err != nil {
+		return r.Host
+	}
+	return host
+}
+
+// parsePath splits a request path into its creator, name and item path.
+// Directory paths are resolved to their `index.html` file.
+func parsePath(p string) (creator string, name string, itemPath string, ok bool) {
+	parts := strings.SplitN(strings.TrimPrefix(p, "/"), "/", 3)
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", false
+	}
+
+	creator, name = parts[0], parts[1]
+	if len(parts) == 3 {
+		itemPath = parts[2]
+	}
+
+	return creator, name, cleanItemPath(itemPath), true
+}
+
+// parseIDPath splits a `/id/<id>/<path>` request path into its deployment
+// identifier and item path.
+func parseIDPath(p string) (id uint64, itemPath string, hasSlash bool, ok bool) {
+	parts := strings.SplitN(strings.TrimPrefix(p, IDPrefix), "/", 2)
+	id, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil || id == 0 {
+		return 0, "", false, false
+	}
+
+	if len(parts) == 2 {
+		itemPath, hasSlash = parts[1], true
+	}
+
+	return id, cleanItemPath(itemPath), hasSlash, true
+}
+
+// cleanItemPath removes any relative segment from the item path and resolves
+// directory paths to their `index.html` file.
+func cleanItemPath(p string) string {
+	isDir := p == "" || strings.HasSuffix(p, "/")
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if isDir {
+		return path.Join(p, IndexHTML)
+	}
+	return p
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.domainRouting {
+		if host := hostname(r); host != "" {
+			res, err := h.queryClient.DeploymentByDomain(r.Context(), &types.QueryDeploymentByDomainRequest{Domain: host})
+			switch status.Code(err) {
+			case codes.OK:
+				// Domains set without a verified claim are not proven to belong to the creator of the deployment
+				if meta := res.GetMeta(); meta.GetDomainVerified() {
+					h.serveItem(w, r, meta.GetCreator(), meta.GetName(), cleanItemPath(strings.TrimPrefix(r.URL.Path, "/")))
+					return
+				}
+			case codes.NotFound:
+				// Fallback to path routing
+			default:
+				writeError(w, err)
+				return
+			}
+		}
+	}
+
+	if strings.HasPrefix(r.URL.Path, IDPrefix) {
+		h.serveByID(w, r)
+		return
+	}
+
+	creator, name, itemPath, ok := parsePath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Redirect `/<creator>/<name>` to `/<creator>/<name>/` so relative links resolve correctly
+	if !strings.HasPrefix(strings.TrimPrefix(r.URL.Path, "/"+creator+"/"+name), "/") {
+		http.Redirect(w, r, r.URL.Path+"/", http.StatusMovedPermanently)
+		return
+	}
+
+	h.serveItem(w, r, creator, name, itemPath)
+}
+
+func (h *Handler) serveByID(w http.ResponseWriter, r *http.Request) {
+	id, itemPath, hasSlash, ok := parseIDPath(r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	// Redirect `/id/<id>` to `/id/<id>/` so relative links resolve correctly
+	if !hasSlash {
+		http.Redirect(w, r, r.URL.Path+"/", http.StatusMovedPermanently)
+		return
+	}
+
+	res, err := h.queryClient.DeploymentById(r.Context(), &types.QueryDeploymentByIdRequest{Id: id})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	meta := res.GetMeta()
+	h.serveItem(w, r, meta.GetCreator(), meta.GetName(), itemPath)
+}
+
+// acceptsEncoding returns true if the Accept-Encoding header of a request allows responses with the given content
+// coding, e.g., gzip or br.
+func acceptsEncoding(r *http.Request, encoding string) bool {
+	for _, header := range r.Header.Values("Accept-Encoding") {
+		for _, part := range strings.Split(header, ",") {
+			coding, params, _ := strings.Cut(part, ";")
+			coding = strings.ToLower(strings.TrimSpace(coding))
+			if coding != encoding && coding != "*" {
+				continue
+			}
+			q, found := strings.CutPrefix(strings.TrimSpace(params), "q=")
+			if !found {
+				return true
+			}
+			if v, err := strconv.ParseFloat(q, 64); err == nil && v > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (h *Handler) serveItem(w http.ResponseWriter, r *http.Request, creator string, name string, itemPath string) {
+	// Contents stored compressed are served as is to the clients accepting them
+	res, err := h.queryClient.Content(r.Context(), &types.QueryContentRequest{
+		Creator: creator,
+		Name:    name,
+		Path:    itemPath,
+		Encoded: acceptsEncoding(r, "br"),
+	})
+	if status.Code(err) == codes.NotFound && path.Base(itemPath) != IndexHTML {
+		// The path might be a directory without a trailing slash
+		if _, derr := h.queryClient.Content(r.Context(), &types.QueryContentRequest{
+			Creator: creator,
+			Name:    name,
+			Path:    path.Join(itemPath, IndexHTML),
+		}); derr == nil {
+			http.Redirect(w, r, r.URL.Path+"/", http.StatusMovedPermanently)
+			return
+		}
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	meta := res.GetMeta()
+	content := res.GetContent()
+	encoding := types.HTTPContentEncoding(res.GetEncoding())
+	if encoding != "" && (meta.GetContentEncoding() != "" || !acceptsEncoding(r, encoding)) {
+		// The content has its own encoding, decode the stored form rather than encoding it twice. The stored form is
+		// also decoded for the clients not accepting brotli
+		content, err = types.DecodeContent(content, res.GetEncoding())
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+			return
+		}
+		encoding = ""
+	}
+
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Add("Vary", "Accept-Encoding")
+	if meta.GetContentType() != "" {
+		w.Header().Set("Content-Type", meta.GetContentType())
+	}
+	if meta.GetCacheControl() != "" {
+		w.Header().Set("Cache-Control", meta.GetCacheControl())
+	}
+	if meta.GetContentEncoding() != "" {
+		w.Header().Set("Content-Encoding", meta.GetContentEncoding())
+	}
+	if encoding != "" {
+		w.Header().Set("Content-Encoding", encoding)
+	}
+	// ServeContent sets the Content-Type from the file extension, sniffing the content as a fallback, unless the
+	// item has a content type
+	http.ServeContent(w, r, itemPath, time.Time{}, bytes.NewReader(content))
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch status.Code(err) {
+	case codes.NotFound:
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	default:
+		http.Error(w, http.StatusText(http.StatusBadGateway), http.StatusBadGateway)
+	}
+}
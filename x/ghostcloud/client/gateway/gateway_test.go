@@ -0,0 +1,253 @@
+package gateway_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/client/gateway"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockQueryClient struct {
+	types.QueryClient
+	items   map[string][]byte
+	metas   map[string]*types.ItemMeta
+	encoded map[string][]byte
+	// encoding is the encoding of the encoded contents
+	encoding types.Encoding
+	domains  map[string]*types.Meta
+	ids      map[uint64]*types.Meta
+}
+
+func (m *mockQueryClient) DeploymentById(_ context.Context, req *types.QueryDeploymentByIdRequest, _ ...grpc.CallOption) (*types.QueryDeploymentByIdResponse, error) {
+	meta, ok := m.ids[req.GetId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.QueryDeploymentByIdResponse{Meta: meta}, nil
+}
+
+func (m *mockQueryClient) DeploymentByDomain(_ context.Context, req *types.QueryDeploymentByDomainRequest, _ ...grpc.CallOption) (*types.QueryDeploymentByDomainResponse, error) {
+	meta, ok := m.domains[req.GetDomain()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	return &types.QueryDeploymentByDomainResponse{Meta: meta}, nil
+}
+
+func (m *mockQueryClient) Content(_ context.Context, req *types.QueryContentRequest, _ ...grpc.CallOption) (*types.QueryContentResponse, error) {
+	key := req.GetCreator() + "/" + req.GetName() + "/" + req.GetPath()
+	content, ok := m.items[key]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if encoded, ok := m.encoded[key]; ok && req.GetEncoded() {
+		return &types.QueryContentResponse{Content: encoded, Meta: m.metas[key], Encoding: m.encoding}, nil
+	}
+	return &types.QueryContentResponse{Content: content, Meta: m.metas[key]}, nil
+}
+
+type GatewayTestCase struct {
+	name        string
+	method      string
+	path        string
+	host        string
+	accept      string
+	code        int
+	contentType string
+	body        string
+	location    string
+	headers     map[string]string
+}
+
+func runGatewayTest(t *testing.T, h http.Handler, tc GatewayTestCase) {
+	t.Run(tc.name, func(t *testing.T) {
+		method := tc.method
+		if method == "" {
+			method = http.MethodGet
+		}
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, tc.path, nil)
+		if tc.host != "" {
+			req.Host = tc.host
+		}
+		if tc.accept != "" {
+			req.Header.Set("Accept-Encoding", tc.accept)
+		}
+		h.ServeHTTP(rec, req)
+
+		require.Equal(t, tc.code, rec.Code)
+		if tc.contentType != "" {
+			require.Equal(t, tc.contentType, rec.Header().Get("Content-Type"))
+		}
+		if tc.body != "" {
+			require.Equal(t, tc.body, rec.Body.String())
+		}
+		if tc.location != "" {
+			require.Equal(t, tc.location, rec.Header().Get("Location"))
+		}
+		for key, value := range tc.headers {
+			require.Equal(t, value, rec.Header().Get(key))
+		}
+	})
+}
+
+func TestGateway(t *testing.T) {
+	addr := sample.AccAddress()
+	prefix := "/" + addr + "/foo"
+	h := gateway.NewHandler(&mockQueryClient{items: map[string][]byte{
+		addr + "/foo/index.html":      []byte(sample.HelloWorldHTMLBody),
+		addr + "/foo/style.css":       []byte("body {}"),
+		addr + "/foo/docs/index.html": []byte(sample.HelloWorldHTMLBody),
+		addr + "/foo/blob":            {0x00, 0x01, 0x02},
+	}})
+
+	tests := []GatewayTestCase{
+		{name: "index", path: prefix + "/", code: http.StatusOK, contentType: "text/html; charset=utf-8", body: sample.HelloWorldHTMLBody},
+		{name: "file", path: prefix + "/style.css", code: http.StatusOK, contentType: "text/css; charset=utf-8", body: "body {}"},
+		{name: "sniffed", path: prefix + "/blob", code: http.StatusOK, contentType: "application/octet-stream"},
+		{name: "directory", path: prefix + "/docs/", code: http.StatusOK, contentType: "text/html; charset=utf-8", body: sample.HelloWorldHTMLBody},
+		{name: "directory_redirect", path: prefix + "/docs", code: http.StatusMovedPermanently, location: prefix + "/docs/"},
+		{name: "deployment_redirect", path: prefix, code: http.StatusMovedPermanently, location: prefix + "/"},
+		{name: "relative", path: prefix + "/docs/../style.css", code: http.StatusOK, body: "body {}"},
+		{name: "head", method: http.MethodHead, path: prefix + "/", code: http.StatusOK, contentType: "text/html; charset=utf-8"},
+		{name: "not_found", path: prefix + "/missing.js", code: http.StatusNotFound},
+		{name: "unknown_deployment", path: "/" + addr + "/bar/", code: http.StatusNotFound},
+		{name: "no_name", path: "/" + addr, code: http.StatusNotFound},
+		{name: "method_not_allowed", method: http.MethodPost, path: prefix + "/", code: http.StatusMethodNotAllowed},
+	}
+	for _, tc := range tests {
+		runGatewayTest(t, h, tc)
+	}
+}
+
+func TestGatewayDomainRouting(t *testing.T) {
+	addr := sample.AccAddress()
+	h := gateway.NewHandler(&mockQueryClient{
+		items: map[string][]byte{
+			addr + "/foo/index.html":      []byte(sample.HelloWorldHTMLBody),
+			addr + "/foo/style.css":       []byte("body {}"),
+			addr + "/foo/docs/index.html": []byte(sample.HelloWorldHTMLBody),
+		},
+		domains: map[string]*types.Meta{
+			"example.com":    {Creator: addr, Name: "foo", Domain: "example.com", DomainVerified: true},
+			"unverified.com": {Creator: addr, Name: "foo", Domain: "unverified.com"},
+		},
+	}, gateway.WithDomainRouting())
+
+	tests := []GatewayTestCase{
+		{name: "index", path: "/", host: "example.com", code: http.StatusOK, contentType: "text/html; charset=utf-8", body: sample.HelloWorldHTMLBody},
+		{name: "file", path: "/style.css", host: "example.com", code: http.StatusOK, body: "body {}"},
+		{name: "port", path: "/style.css", host: "example.com:8080", code: http.StatusOK, body: "body {}"},
+		{name: "directory", path: "/docs/", host: "example.com", code: http.StatusOK, body: sample.HelloWorldHTMLBody},
+		{name: "directory_redirect", path: "/docs", host: "example.com", code: http.StatusMovedPermanently, location: "/docs/"},
+		{name: "not_found", path: "/missing.js", host: "example.com", code: http.StatusNotFound},
+		{name: "path_fallback", path: "/" + addr + "/foo/style.css", host: "unknown.com", code: http.StatusOK, body: "body {}"},
+		{name: "unknown_domain", path: "/", host: "unknown.com", code: http.StatusNotFound},
+		// Unverified domains fall back to path routing
+		{name: "unverified_domain", path: "/style.css", host: "unverified.com", code: http.StatusNotFound},
+		{name: "unverified_path_fallback", path: "/" + addr + "/foo/style.css", host: "unverified.com", code: http.StatusOK, body: "body {}"},
+	}
+	for _, tc := range tests {
+		runGatewayTest(t, h, tc)
+	}
+}
+
+func TestGatewayIDRouting(t *testing.T) {
+	addr := sample.AccAddress()
+	h := gateway.NewHandler(&mockQueryClient{
+		items: map[string][]byte{
+			addr + "/foo/index.html":      []byte(sample.HelloWorldHTMLBody),
+			addr + "/foo/style.css":       []byte("body {}"),
+			addr + "/foo/docs/index.html": []byte(sample.HelloWorldHTMLBody),
+		},
+		ids: map[uint64]*types.Meta{
+			42: {Creator: addr, Name: "foo", Id: 42},
+		},
+	})
+
+	tests := []GatewayTestCase{
+		{name: "index", path: "/id/42/", code: http.StatusOK, contentType: "text/html; charset=utf-8", body: sample.HelloWorldHTMLBody},
+		{name: "file", path: "/id/42/style.css", code: http.StatusOK, body: "body {}"},
+		{name: "directory_redirect", path: "/id/42/docs", code: http.StatusMovedPermanently, location: "/id/42/docs/"},
+		{name: "deployment_redirect", path: "/id/42", code: http.StatusMovedPermanently, location: "/id/42/"},
+		{name: "not_found", path: "/id/42/missing.js", code: http.StatusNotFound},
+		{name: "unknown_id", path: "/id/43/", code: http.StatusNotFound},
+		{name: "invalid_id", path: "/id/foo/", code: http.StatusNotFound},
+		{name: "zero_id", path: "/id/0/", code: http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		runGatewayTest(t, h, tc)
+	}
+}
+
+func TestGatewayItemHeaders(t *testing.T) {
+	addr := sample.AccAddress()
+	prefix := "/" + addr + "/foo"
+	h := gateway.NewHandler(&mockQueryClient{
+		items: map[string][]byte{
+			addr + "/foo/index.html": []byte(sample.HelloWorldHTMLBody),
+			addr + "/foo/feed":       []byte("<rss></rss>"),
+			addr + "/foo/app.js.gz":  {0x1f, 0x8b},
+		},
+		metas: map[string]*types.ItemMeta{
+			addr + "/foo/index.html": {Path: "index.html", ContentType: "text/html; charset=utf-8", CacheControl: "no-cache"},
+			addr + "/foo/feed":       {Path: "feed", ContentType: "application/rss+xml"},
+			addr + "/foo/app.js.gz":  {Path: "app.js.gz", ContentType: "text/javascript; charset=utf-8", CacheControl: "max-age=31536000, immutable", ContentEncoding: "gzip"},
+		},
+	})
+
+	tests := []GatewayTestCase{
+		{name: "cache_control", path: prefix + "/", code: http.StatusOK, contentType: "text/html; charset=utf-8", headers: map[string]string{"Cache-Control": "no-cache", "Content-Encoding": ""}},
+		{name: "content_type", path: prefix + "/feed", code: http.StatusOK, contentType: "application/rss+xml", body: "<rss></rss>"},
+		{name: "content_encoding", path: prefix + "/app.js.gz", code: http.StatusOK, contentType: "text/javascript; charset=utf-8", headers: map[string]string{"Cache-Control": "max-age=31536000, immutable", "Content-Encoding": "gzip"}},
+	}
+	for _, tc := range tests {
+		runGatewayTest(t, h, tc)
+	}
+}
+
+func TestGatewayEncodedContent(t *testing.T) {
+	addr := sample.AccAddress()
+	prefix := "/" + addr + "/foo"
+	html := strings.Repeat("<p>Hello, World!</p>", 100)
+	encoded, encoding := types.EncodeContent(&types.ItemMeta{ContentType: "text/html; charset=utf-8"}, []byte(html))
+	require.Equal(t, types.Encoding_ENCODING_BROTLI, encoding)
+
+	h := gateway.NewHandler(&mockQueryClient{
+		items: map[string][]byte{
+			addr + "/foo/index.html": []byte(html),
+			addr + "/foo/page.gz":    []byte(html),
+		},
+		metas: map[string]*types.ItemMeta{
+			addr + "/foo/index.html": {Path: "index.html", ContentType: "text/html; charset=utf-8"},
+			addr + "/foo/page.gz":    {Path: "page.gz", ContentType: "text/html; charset=utf-8", ContentEncoding: "gzip"},
+		},
+		encoded: map[string][]byte{
+			addr + "/foo/index.html": encoded,
+			addr + "/foo/page.gz":    encoded,
+		},
+		encoding: encoding,
+	})
+
+	tests := []GatewayTestCase{
+		{name: "br", path: prefix + "/", accept: "gzip, deflate, br", code: http.StatusOK, contentType: "text/html; charset=utf-8", body: string(encoded), headers: map[string]string{"Content-Encoding": "br", "Vary": "Accept-Encoding"}},
+		{name: "wildcard", path: prefix + "/", accept: "*", code: http.StatusOK, body: string(encoded), headers: map[string]string{"Content-Encoding": "br"}},
+		{name: "identity", path: prefix + "/", code: http.StatusOK, body: html, headers: map[string]string{"Content-Encoding": "", "Vary": "Accept-Encoding"}},
+		{name: "gzip_only", path: prefix + "/", accept: "gzip", code: http.StatusOK, body: html, headers: map[string]string{"Content-Encoding": ""}},
+		{name: "refused", path: prefix + "/", accept: "gzip, br;q=0", code: http.StatusOK, body: html, headers: map[string]string{"Content-Encoding": ""}},
+		{name: "own_encoding", path: prefix + "/page.gz", accept: "br", code: http.StatusOK, body: html, headers: map[string]string{"Content-Encoding": "gzip"}},
+	}
+	for _, tc := range tests {
+		runGatewayTest(t, h, tc)
+	}
+}
@@ -9,12 +9,58 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set the params first, the number of retained revisions depends on them
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
+
+	// Restore the identifier sequence first, deployments without identifier are assigned a new one
+	var seq uint64
+	for _, deployment := range genState.Deployments {
+		if deployment.Meta.Id > seq {
+			seq = deployment.Meta.Id
+		}
+	}
+	k.SetDeploymentSeq(ctx, seq)
+
 	for _, deployment := range genState.Deployments {
 		addr := sdk.MustAccAddressFromBech32(deployment.Meta.Creator)
+		if deployment.Meta.Id == 0 {
+			deployment.Meta.Id = k.NextDeploymentID(ctx)
+		}
 		k.SetDeployment(ctx, addr, deployment.Meta, deployment.Dataset)
 	}
+
+	// Restore the revisions, deployments without revision start a new history from their current files
+	contents := make(map[string][]byte)
+	for _, content := range genState.RevisionContents {
+		contents[string(types.ContentHash(content.Content))] = content.Content
+	}
+	for _, elem := range genState.Revisions {
+		addr := sdk.MustAccAddressFromBech32(elem.Creator)
+		if err := k.ImportRevision(ctx, addr, elem.Name, elem.Revision, contents); err != nil {
+			panic(err)
+		}
+	}
+	for _, deployment := range genState.Deployments {
+		addr := sdk.MustAccAddressFromBech32(deployment.Meta.Creator)
+		if k.GetLatestRevisionNumber(ctx, addr, deployment.Meta.Name) == 0 {
+			k.RecordRevision(ctx, addr, deployment.Meta.Name)
+		}
+	}
+	for _, claim := range genState.DomainClaims {
+		addr := sdk.MustAccAddressFromBech32(claim.Creator)
+		k.SetDomainClaim(ctx, addr, claim)
+	}
+	for _, transfer := range genState.Transfers {
+		addr := sdk.MustAccAddressFromBech32(transfer.Creator)
+		k.SetTransfer(ctx, addr, transfer)
+	}
+	for _, collaborator := range genState.Collaborators {
+		addr := sdk.MustAccAddressFromBech32(collaborator.Creator)
+		k.SetCollaborator(ctx, addr, collaborator)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
-	k.SetParams(ctx, genState.Params)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -22,7 +68,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
-	genesis.Deployments = keeper.GetAllDeployments(ctx, k)
+	deployments, err := keeper.GetAllDeployments(ctx, k)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Deployments = deployments
+	genesis.DomainClaims = k.GetAllDomainClaims(ctx)
+	genesis.Transfers = k.GetAllTransfers(ctx)
+	genesis.Collaborators = k.GetAllCollaborators(ctx)
+	revisions, revisionContents, err := keeper.GetAllRevisions(ctx, k)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Revisions, genesis.RevisionContents = revisions, revisionContents
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -8,15 +8,29 @@ import (
 	keepertest "ghostcloud/testutil/keeper"
 	"ghostcloud/testutil/nullify"
 	"ghostcloud/x/ghostcloud"
+	"ghostcloud/x/ghostcloud/keeper"
 	"ghostcloud/x/ghostcloud/types"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesis(t *testing.T) {
+	deployments := sample.CreateNDeployments(keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE)
+	deployments[1].Meta.Id = 42
 	genesisState := types.GenesisState{
 		Params:      types.DefaultParams(),
-		Deployments: sample.CreateNDeployments(keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE),
+		Deployments: deployments,
+		DomainClaims: []*types.DomainClaim{
+			{Creator: deployments[0].Meta.Creator, Name: deployments[0].Meta.Name, Domain: "example.com", Token: "token"},
+		},
+		Transfers: []*types.DeploymentTransfer{
+			{Creator: deployments[0].Meta.Creator, Name: deployments[0].Meta.Name, Recipient: sample.AccAddress()},
+		},
+		Collaborators: []*types.Collaborator{
+			{Creator: deployments[0].Meta.Creator, Name: deployments[0].Meta.Name, Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -24,10 +38,167 @@ func TestGenesis(t *testing.T) {
 	ghostcloud.InitGenesis(ctx, *k, genesisState)
 	got := ghostcloud.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
+	require.Len(t, got.Transfers, 1)
+	require.Equal(t, genesisState.Collaborators, got.Collaborators)
+
+	// Deployments without identifier are assigned one after the highest imported identifier
+	ids := make(map[uint64]struct{})
+	for _, deployment := range got.Deployments {
+		require.NotZero(t, deployment.Meta.Id)
+		ids[deployment.Meta.Id] = struct{}{}
+	}
+	require.Len(t, ids, len(deployments))
+	meta, found := k.GetMetaByID(ctx, 42)
+	require.True(t, found)
+	require.Equal(t, deployments[1].Meta.Name, meta.Name)
+	require.Equal(t, uint64(42+keepertest.NUM_DEPLOYMENT-1), k.GetDeploymentSeq(ctx))
 
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
 	require.ElementsMatch(t, genesisState.Deployments, got.Deployments)
+	require.ElementsMatch(t, genesisState.DomainClaims, got.DomainClaims)
+	require.ElementsMatch(t, genesisState.Transfers, got.Transfers)
+	require.ElementsMatch(t, genesisState.Collaborators, got.Collaborators)
+	for _, deployment := range deployments {
+		addr := sdk.MustAccAddressFromBech32(deployment.Meta.Creator)
+		require.Equal(t, uint64(1), k.GetLatestRevisionNumber(ctx, addr, deployment.Meta.Name))
+	}
 	// this line is used by starport scaffolding # genesis/test/assert
 }
+
+func TestGenesisRevisions(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	creator := sample.AccAddress()
+	addr := sdk.MustAccAddressFromBech32(creator)
+	meta := &types.Meta{Creator: creator, Name: "foo"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: datasetPayload("<h1>v1</h1>")})
+	require.NoError(t, err)
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: meta, Payload: datasetPayload("<h1>v2</h1>")})
+	require.NoError(t, err)
+
+	exported := ghostcloud.ExportGenesis(ctx, *k)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.Revisions, 2)
+	// Only the content of the first revision is not referenced by the files of the deployment
+	require.Equal(t, []*types.ItemContent{{Content: []byte("<h1>v1</h1>")}}, exported.RevisionContents)
+
+	imported, importedCtx := keepertest.GhostcloudKeeper(t)
+	ghostcloud.InitGenesis(importedCtx, *imported, *exported)
+	require.Equal(t, exported.Revisions, ghostcloud.ExportGenesis(importedCtx, *imported).Revisions)
+	require.Equal(t, uint64(2), imported.GetLatestRevisionNumber(importedCtx, addr, "foo"))
+
+	// The first revision can still be restored
+	_, err = keeper.NewMsgServerImpl(*imported).RollbackDeployment(sdk.WrapSDKContext(importedCtx), &types.MsgRollbackDeploymentRequest{Creator: creator, Name: "foo", Revision: 1})
+	require.NoError(t, err)
+	content, found, err := imported.GetItemContent(importedCtx, addr, "foo", "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "<h1>v1</h1>", string(content.Content))
+}
+
+func TestGenesisValidateRevisions(t *testing.T) {
+	deployments := sample.CreateNDeployments(1, 1)
+	creator, name := deployments[0].Meta.Creator, deployments[0].Meta.Name
+	item := deployments[0].Dataset.Items[0]
+	live := &types.ItemMeta{Path: item.Meta.Path, Hash: types.ContentHash(item.Content.Content), Size_: uint64(len(item.Content.Content))}
+	old := &types.ItemMeta{Path: "index.html", Hash: types.ContentHash([]byte("old")), Size_: 3}
+
+	tests := []struct {
+		name      string
+		revisions []*types.DeploymentRevision
+		contents  []*types.ItemContent
+		err       string
+	}{
+		{
+			name: "valid",
+			revisions: []*types.DeploymentRevision{
+				{Creator: creator, Name: name, Revision: &types.Revision{Number: 1, Items: []*types.ItemMeta{old}}},
+				{Creator: creator, Name: name, Revision: &types.Revision{Number: 2, Items: []*types.ItemMeta{live}}},
+			},
+			contents: []*types.ItemContent{{Content: []byte("old")}},
+		},
+		{
+			name:      "unknown deployment",
+			revisions: []*types.DeploymentRevision{{Creator: creator, Name: "bar", Revision: &types.Revision{Number: 1}}},
+			err:       "revision of unknown deployment",
+		},
+		{
+			name: "duplicated revision",
+			revisions: []*types.DeploymentRevision{
+				{Creator: creator, Name: name, Revision: &types.Revision{Number: 1}},
+				{Creator: creator, Name: name, Revision: &types.Revision{Number: 1}},
+			},
+			err: "duplicated index for revision",
+		},
+		{
+			name:      "no number",
+			revisions: []*types.DeploymentRevision{{Creator: creator, Name: name, Revision: &types.Revision{}}},
+			err:       "invalid revision",
+		},
+		{
+			name:      "unknown content",
+			revisions: []*types.DeploymentRevision{{Creator: creator, Name: name, Revision: &types.Revision{Number: 1, Items: []*types.ItemMeta{old}}}},
+			err:       "unknown content for revision item",
+		},
+		{
+			name: "invalid size",
+			revisions: []*types.DeploymentRevision{{Creator: creator, Name: name, Revision: &types.Revision{Number: 1, Items: []*types.ItemMeta{
+				{Path: "index.html", Hash: old.Hash, Size_: 4},
+			}}}},
+			contents: []*types.ItemContent{{Content: []byte("old")}},
+			err:      "invalid content size for revision item",
+		},
+		{
+			name: "non-canonical path",
+			revisions: []*types.DeploymentRevision{{Creator: creator, Name: name, Revision: &types.Revision{Number: 1, Items: []*types.ItemMeta{
+				{Path: "./index.html", Hash: old.Hash, Size_: 3},
+			}}}},
+			contents: []*types.ItemContent{{Content: []byte("old")}},
+			err:      "non-canonical path for revision item",
+		},
+		{
+			name:     "duplicated content",
+			contents: []*types.ItemContent{{Content: item.Content.Content}},
+			err:      "duplicated revision content",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			genesisState := types.GenesisState{
+				Params:           types.DefaultParams(),
+				Deployments:      deployments,
+				Revisions:        tt.revisions,
+				RevisionContents: tt.contents,
+			}
+			err := genesisState.Validate()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// Retained revisions are limited
+	params := types.DefaultParams()
+	params.MaxRevisions = 1
+	genesisState := types.GenesisState{
+		Params:      params,
+		Deployments: deployments,
+		Revisions: []*types.DeploymentRevision{
+			{Creator: creator, Name: name, Revision: &types.Revision{Number: 1}},
+			{Creator: creator, Name: name, Revision: &types.Revision{Number: 2}},
+		},
+	}
+	require.ErrorContains(t, genesisState.Validate(), "too many revisions")
+}
+
+func datasetPayload(index string) *types.Payload {
+	return &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{
+		{Meta: &types.ItemMeta{Path: "index.html"}, Content: &types.ItemContent{Content: []byte(index)}},
+	}}}}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) SetCollaborator(ctx sdk.Context, addr sdk.AccAddress, collaborator *types.Collaborator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollaboratorKeyPrefix)
+	collaboratorAddr := sdk.MustAccAddressFromBech32(collaborator.GetAddress())
+	store.Set(types.CollaboratorKey(addr, collaborator.GetName(), collaboratorAddr), k.cdc.MustMarshal(collaborator))
+}
+
+func (k Keeper) GetCollaborator(ctx sdk.Context, addr sdk.AccAddress, name string, collaboratorAddr sdk.AccAddress) (collaborator types.Collaborator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollaboratorKeyPrefix)
+	b := store.Get(types.CollaboratorKey(addr, name, collaboratorAddr))
+	if b == nil {
+		return collaborator, false
+	}
+
+	k.cdc.MustUnmarshal(b, &collaborator)
+	return collaborator, true
+}
+
+func (k Keeper) RemoveCollaborator(ctx sdk.Context, addr sdk.AccAddress, name string, collaboratorAddr sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CollaboratorKeyPrefix)
+	store.Delete(types.CollaboratorKey(addr, name, collaboratorAddr))
+}
+
+// removeCollaborators removes all the collaborators of a deployment.
+func (k Keeper) removeCollaborators(ctx sdk.Context, addr sdk.AccAddress, name string) {
+	store := k.getCollaboratorStore(ctx, addr, name)
+	iterator := store.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) GetAllCollaborators(ctx sdk.Context) (collaborators []*types.Collaborator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.CollaboratorKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var collaborator types.Collaborator
+		k.cdc.MustUnmarshal(iterator.Value(), &collaborator)
+
+		collaborators = append(collaborators, &collaborator)
+	}
+
+	return collaborators
+}
+
+func (k Keeper) getCollaboratorStore(ctx sdk.Context, addr sdk.AccAddress, name string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), append(append([]byte{}, types.CollaboratorKeyPrefix...), types.DeploymentKey(addr, name)...))
+}
+
+// authorize verifies that the signer is the creator of a deployment, or one of its collaborators with at least the
+// given role.
+func (k Keeper) authorize(ctx sdk.Context, addr sdk.AccAddress, name string, signer string, role types.CollaboratorRole) error {
+	signerAddr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, types.InvalidSignerAddress, err)
+	}
+	if signerAddr.Equals(addr) {
+		return nil
+	}
+
+	collaborator, found := k.GetCollaborator(ctx, addr, name, signerAddr)
+	if !found || collaborator.GetRole() < role {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to modify this deployment", signer)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"math/big"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// RequiredDeposit returns the deposit required for the files of a deployment, given their total size in bytes. It
+// returns an error if the deposit overflows.
+func RequiredDeposit(depositPerByte sdk.Coins, size uint64) (sdk.Coins, error) {
+	var deposit sdk.Coins
+	for _, coin := range depositPerByte {
+		amount := new(big.Int).Mul(coin.Amount.BigInt(), new(big.Int).SetUint64(size))
+		if amount.BitLen() > sdkmath.MaxBitLen {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.DepositOverflow, depositPerByte, size)
+		}
+		deposit = deposit.Add(sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(amount)))
+	}
+	if deposit.IsZero() {
+		return nil, nil
+	}
+	return deposit, nil
+}
+
+// RenewalFee returns the fee paid to extend the expiry of a deployment by the given number of blocks. It returns an
+// error if the fee overflows.
+func RenewalFee(renewalFeePerBlock sdk.Coins, blocks int64) (sdk.Coins, error) {
+	var fee sdk.Coins
+	for _, coin := range renewalFeePerBlock {
+		amount := new(big.Int).Mul(coin.Amount.BigInt(), big.NewInt(blocks))
+		if amount.BitLen() > sdkmath.MaxBitLen {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.RenewalFeeOverflow, renewalFeePerBlock, blocks)
+		}
+		fee = fee.Add(sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(amount)))
+	}
+	if fee.IsZero() {
+		return nil, nil
+	}
+	return fee, nil
+}
+
+// getDeploymentSize returns the total size in bytes of the files of a deployment.
+func (k Keeper) getDeploymentSize(ctx sdk.Context, addr sdk.AccAddress, name string) (size uint64) {
+	for _, itemSize := range k.GetItemSizes(ctx, addr, name) {
+		size += itemSize
+	}
+	return size
+}
+
+// getRetainedSize returns the total size in bytes of the contents retained by a deployment, i.e., by its files and
+// its revisions. Each content is counted once.
+func (k Keeper) getRetainedSize(ctx sdk.Context, addr sdk.AccAddress, name string) (size uint64) {
+	retained := make(map[string]struct{})
+	retain := func(items []*types.ItemMeta) {
+		for _, item := range items {
+			if _, ok := retained[string(item.GetHash())]; ok {
+				continue
+			}
+			retained[string(item.GetHash())] = struct{}{}
+			size += item.GetSize_()
+		}
+	}
+
+	retain(k.getItemMetas(ctx, addr, name))
+	iterator := k.getRevisionStore(ctx, addr, name).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.Revision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+		retain(revision.GetItems())
+	}
+
+	return size
+}
+
+// settleDeposit escrows from the creator the missing part of the deposit required for the contents retained by a
+// deployment, i.e., its current files and the older contents kept alive by its revisions, or refunds the excess. The
+// new deposit and the total size of the files are recorded in the meta of the deployment.
+func (k Keeper) settleDeposit(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) error {
+	size := k.getDeploymentSize(ctx, addr, meta.Name)
+	required, err := RequiredDeposit(k.GetParams(ctx).DepositPerByte, k.getRetainedSize(ctx, addr, meta.Name))
+	if err != nil {
+		return err
+	}
+	held := meta.Deposit.Min(required)
+
+	if collect := required.Sub(held...); !collect.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, collect); err != nil {
+			return errorsmod.Wrap(err, "unable to escrow the deployment deposit")
+		}
+	}
+	if refund := meta.Deposit.Sub(held...); !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, refund); err != nil {
+			return errorsmod.Wrap(err, "unable to refund the deployment deposit")
+		}
+	}
+
+	meta.Deposit = required
+	meta.TotalSize = size
+	k.SetMeta(ctx, addr, meta)
+	return nil
+}
+
+// refundDeposit refunds the whole deposit of a deployment to its creator.
+func (k Keeper) refundDeposit(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) error {
+	if meta.Deposit.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, meta.Deposit); err != nil {
+		return errorsmod.Wrap(err, "unable to refund the deployment deposit")
+	}
+	return nil
+}
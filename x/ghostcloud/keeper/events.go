@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// changedPaths returns the sorted paths of the items added, replaced or deleted between two datasets.
+func changedPaths(before []*types.ItemMeta, after []*types.ItemMeta) []string {
+	hashes := make(map[string][]byte, len(before))
+	for _, item := range before {
+		hashes[item.GetPath()] = item.GetHash()
+	}
+
+	var paths []string
+	for _, item := range after {
+		hash, found := hashes[item.GetPath()]
+		if !found || !bytes.Equal(hash, item.GetHash()) {
+			paths = append(paths, item.GetPath())
+		}
+		delete(hashes, item.GetPath())
+	}
+	for path := range hashes {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+	return paths
+}
+
+func (k Keeper) emitDeploymentCreated(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentCreated{
+		Creator:     meta.GetCreator(),
+		Name:        meta.GetName(),
+		Domain:      meta.GetDomain(),
+		Revision:    k.GetLatestRevisionNumber(ctx, addr, meta.GetName()),
+		DatasetHash: types.DatasetHash(k.getItemMetas(ctx, addr, meta.GetName())),
+	})
+}
+
+// emitDeploymentUpdated emits an EventDeploymentUpdated for the given updated meta. The changed paths are computed
+// from the item metas of the deployment before the update.
+func (k Keeper) emitDeploymentUpdated(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta, before []*types.ItemMeta) error {
+	after := k.getItemMetas(ctx, addr, meta.GetName())
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentUpdated{
+		Creator:      meta.GetCreator(),
+		Name:         meta.GetName(),
+		Domain:       meta.GetDomain(),
+		Revision:     k.GetLatestRevisionNumber(ctx, addr, meta.GetName()),
+		DatasetHash:  types.DatasetHash(after),
+		ChangedPaths: changedPaths(before, after),
+	})
+}
+
+func emitDeploymentRemoved(ctx sdk.Context, creator string, name string) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentRemoved{
+		Creator: creator,
+		Name:    name,
+	})
+}
+
+func emitDeploymentExpired(ctx sdk.Context, meta *types.Meta) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentExpired{
+		Creator:         meta.Creator,
+		Name:            meta.Name,
+		ExpiresAtHeight: meta.ExpiresAtHeight,
+	})
+}
+
+func emitDeploymentExpiryFailed(ctx sdk.Context, meta *types.Meta, err error) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentExpiryFailed{
+		Creator:         meta.Creator,
+		Name:            meta.Name,
+		ExpiresAtHeight: meta.ExpiresAtHeight,
+		Error:           err.Error(),
+	})
+}
+
+func emitDeploymentRenewed(ctx sdk.Context, meta *types.Meta) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentRenewed{
+		Creator:         meta.Creator,
+		Name:            meta.Name,
+		ExpiresAtHeight: meta.ExpiresAtHeight,
+	})
+}
+
+func emitDeploymentTransferred(ctx sdk.Context, meta *types.Meta, previousOwner string) error {
+	return ctx.EventManager().EmitTypedEvent(&types.EventDeploymentTransferred{
+		Id:            meta.Id,
+		Name:          meta.Name,
+		PreviousOwner: previousOwner,
+		NewOwner:      meta.Creator,
+	})
+}
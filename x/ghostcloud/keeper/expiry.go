@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) setExpiry(ctx sdk.Context, addr sdk.AccAddress, name string, height int64) {
+	if height == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentExpiryKeyPrefix)
+	store.Set(types.ExpiryKey(height, addr, name), []byte{})
+}
+
+func (k Keeper) removeExpiry(ctx sdk.Context, addr sdk.AccAddress, name string, height int64) {
+	if height == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentExpiryKeyPrefix)
+	store.Delete(types.ExpiryKey(height, addr, name))
+}
+
+// PruneExpiredDeployments removes the deployments expiring at or before the current block height, oldest expiry
+// first, up to the expired prune limit param. The remaining ones are removed at the end of the following blocks.
+// The deposit of each removed deployment is refunded to its creator. A deployment whose refund fails is kept and
+// retried at the end of the following blocks. Expiry entries not matching a deployment are deleted; they count
+// against the limit.
+func (k Keeper) PruneExpiredDeployments(ctx sdk.Context) error {
+	limit := k.GetParams(ctx).ExpiredPruneLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentExpiryKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	var expired []types.Meta
+	var dangling [][]byte
+	for ; iterator.Valid() && uint64(len(expired)+len(dangling)) < limit; iterator.Next() {
+		key := iterator.Key()
+		if len(key) < 8 {
+			dangling = append(dangling, key)
+			continue
+		}
+		// Skip the 8 bytes of the expiry height
+		addr, name, _, err := types.ParseDeploymentKey(key[8:])
+		if err != nil {
+			dangling = append(dangling, key)
+			continue
+		}
+		meta, found := k.GetMeta(ctx, addr, name)
+		if !found || uint64(meta.ExpiresAtHeight) != sdk.BigEndianToUint64(key[:8]) {
+			dangling = append(dangling, key)
+			continue
+		}
+		expired = append(expired, meta)
+	}
+	iterator.Close()
+
+	for _, key := range dangling {
+		store.Delete(key)
+	}
+
+	for i := range expired {
+		meta := &expired[i]
+		addr := sdk.MustAccAddressFromBech32(meta.Creator)
+		refundCtx, write := ctx.CacheContext()
+		if err := k.refundDeposit(refundCtx, addr, meta); err != nil {
+			k.Logger(ctx).Error("failed to refund the deposit of an expired deployment",
+				"creator", meta.Creator, "name", meta.Name, "error", err)
+			if err := emitDeploymentExpiryFailed(ctx, meta, err); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+		k.Remove(ctx, addr, meta.Name)
+		if err := emitDeploymentExpired(ctx, meta); err != nil {
+			return err
+		}
+	}
+	return nil
+}
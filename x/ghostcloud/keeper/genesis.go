@@ -6,7 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func GetAllDeployments(ctx sdk.Context, k Keeper) (deployments []*types.Deployment) {
+func GetAllDeployments(ctx sdk.Context, k Keeper) (deployments []*types.Deployment, err error) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentMetaKeyPrefix)
 
@@ -17,7 +17,10 @@ func GetAllDeployments(ctx sdk.Context, k Keeper) (deployments []*types.Deployme
 		k.cdc.MustUnmarshal(iterator.Value(), &meta)
 
 		creator := sdk.MustAccAddressFromBech32(meta.GetCreator())
-		dataset := k.GetDataset(ctx, creator, meta.GetName())
+		dataset, err := k.GetDataset(ctx, creator, meta.GetName())
+		if err != nil {
+			return nil, err
+		}
 
 		deployments = append(deployments, &types.Deployment{
 			Meta:    &meta,
@@ -25,5 +28,53 @@ func GetAllDeployments(ctx sdk.Context, k Keeper) (deployments []*types.Deployme
 		})
 	}
 
-	return
+	return deployments, nil
+}
+
+// GetAllRevisions returns the revisions of all the deployments, along with the contents only referenced by revisions.
+// The other contents are exported with the files of the deployments.
+func GetAllRevisions(ctx sdk.Context, k Keeper) (revisions []*types.DeploymentRevision, contents []*types.ItemContent, err error) {
+	// Hashes of the contents of the files of the deployments
+	live := make(map[string]struct{})
+	itemIterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+	for ; itemIterator.Valid(); itemIterator.Next() {
+		var meta types.ItemMeta
+		k.cdc.MustUnmarshal(itemIterator.Value(), &meta)
+		live[string(meta.GetHash())] = struct{}{}
+	}
+	itemIterator.Close()
+
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.Revision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+
+		addr, name, _, err := types.ParseDeploymentKey(iterator.Key()[len(types.RevisionKeyPrefix):])
+		if err != nil {
+			return nil, nil, err
+		}
+		revisions = append(revisions, &types.DeploymentRevision{
+			Creator:  addr.String(),
+			Name:     name,
+			Revision: &revision,
+		})
+
+		for _, item := range revision.GetItems() {
+			if _, ok := live[string(item.GetHash())]; ok {
+				continue
+			}
+			live[string(item.GetHash())] = struct{}{}
+			content, found, err := k.GetBlob(ctx, item.GetHash())
+			if err != nil {
+				return nil, nil, err
+			}
+			if found {
+				contents = append(contents, &types.ItemContent{Content: content})
+			}
+		}
+	}
+
+	return revisions, contents, nil
 }
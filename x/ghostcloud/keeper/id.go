@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextDeploymentID returns a new deployment identifier.
+func (k Keeper) NextDeploymentID(ctx sdk.Context) uint64 {
+	id := k.GetDeploymentSeq(ctx) + 1
+	k.SetDeploymentSeq(ctx, id)
+	return id
+}
+
+// GetDeploymentSeq returns the last assigned deployment identifier.
+func (k Keeper) GetDeploymentSeq(ctx sdk.Context) uint64 {
+	b := ctx.KVStore(k.storeKey).Get(types.DeploymentSeqKey)
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+// SetDeploymentSeq sets the last assigned deployment identifier.
+func (k Keeper) SetDeploymentSeq(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.DeploymentSeqKey, sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) setDeploymentID(ctx sdk.Context, addr sdk.AccAddress, name string, id uint64) {
+	if id == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentIdKeyPrefix)
+	store.Set(types.DeploymentIdKey(id), types.DeploymentKey(addr, name))
+}
+
+func (k Keeper) removeDeploymentID(ctx sdk.Context, id uint64) {
+	if id == 0 {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentIdKeyPrefix)
+	store.Delete(types.DeploymentIdKey(id))
+}
+
+// GetMetaByID returns the meta of the deployment with the given stable identifier.
+func (k Keeper) GetMetaByID(ctx sdk.Context, id uint64) (meta types.Meta, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentIdKeyPrefix)
+	b := store.Get(types.DeploymentIdKey(id))
+	if b == nil {
+		return meta, false
+	}
+
+	addr, name, _, err := types.ParseDeploymentKey(b)
+	if err != nil {
+		return meta, false
+	}
+
+	return k.GetMeta(ctx, addr, name)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -9,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"ghostcloud/x/ghostcloud/types"
 )
@@ -19,7 +19,10 @@ type (
 		cdc        codec.BinaryCodec
 		storeKey   storetypes.StoreKey
 		memKey     storetypes.StoreKey
-		paramstore paramtypes.Subspace
+		bankKeeper types.BankKeeper
+
+		// the address capable of verifying domain claims and updating the params, usually the x/gov module account.
+		authority string
 	}
 )
 
@@ -27,22 +30,23 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey,
 	memKey storetypes.StoreKey,
-	ps paramtypes.Subspace,
-
+	bankKeeper types.BankKeeper,
+	authority string,
 ) *Keeper {
-	// set KeyTable if it has not already been set
-	if !ps.HasKeyTable() {
-		ps = ps.WithKeyTable(types.ParamKeyTable())
-	}
-
 	return &Keeper{
 		cdc:        cdc,
 		storeKey:   storeKey,
 		memKey:     memKey,
-		paramstore: ps,
+		bankKeeper: bankKeeper,
+		authority:  authority,
 	}
 }
 
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -52,17 +56,113 @@ func (k Keeper) HasDeployment(ctx sdk.Context, creator sdk.AccAddress, name stri
 	return store.Has(types.DeploymentKey(creator, name))
 }
 
+// SetDeployment stores the meta and files of a deployment. The total size of the meta is derived from the files.
 func (k Keeper) SetDeployment(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta, dataset *types.Dataset) {
+	meta.TotalSize = 0
+	for _, item := range dataset.GetItems() {
+		meta.TotalSize += uint64(len(item.GetContent().GetContent()))
+	}
 	k.SetMeta(ctx, addr, meta)
 	k.SetDataset(ctx, addr, meta.GetName(), dataset)
 }
 
 func (k Keeper) SetMeta(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) {
+	if prev, found := k.GetMeta(ctx, addr, meta.GetName()); found {
+		k.removeDomain(ctx, addr, prev.GetName(), prev.GetDomain())
+		k.removeCreationHeight(ctx, addr, prev.GetName(), prev.GetCreatedAtHeight())
+		k.removeSortKeys(ctx, addr, &prev)
+		k.removeExpiry(ctx, addr, prev.GetName(), prev.GetExpiresAtHeight())
+		k.removeDeploymentID(ctx, prev.GetId())
+	}
+	k.setDomain(ctx, addr, meta.GetName(), meta.GetDomain())
+	k.setCreationHeight(ctx, addr, meta.GetName(), meta.GetCreatedAtHeight())
+	k.setSortKeys(ctx, addr, meta)
+	k.setExpiry(ctx, addr, meta.GetName(), meta.GetExpiresAtHeight())
+	k.setDeploymentID(ctx, addr, meta.GetName(), meta.GetId())
+
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentMetaKeyPrefix)
 	b := k.cdc.MustMarshal(meta)
 	store.Set(types.DeploymentKey(addr, meta.GetName()), b)
 }
 
+// touchMeta records the creation or an update of a deployment at the current block, i.e., its update height and time,
+// and increments its version.
+func touchMeta(ctx sdk.Context, meta *types.Meta) {
+	meta.UpdatedAtHeight = ctx.BlockHeight()
+	meta.UpdatedAtTime = ctx.BlockTime()
+	meta.Version++
+}
+
+func (k Keeper) setDomain(ctx sdk.Context, addr sdk.AccAddress, name string, domain string) {
+	if domain == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentDomainKeyPrefix)
+	store.Set(types.DomainKey(domain), types.DeploymentKey(addr, name))
+}
+
+// removeDomain removes the domain from the index if it points to the given deployment.
+func (k Keeper) removeDomain(ctx sdk.Context, addr sdk.AccAddress, name string, domain string) {
+	if domain == "" {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentDomainKeyPrefix)
+	key := types.DomainKey(domain)
+	if bytes.Equal(store.Get(key), types.DeploymentKey(addr, name)) {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setCreationHeight(ctx sdk.Context, addr sdk.AccAddress, name string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentCreationHeightKeyPrefix)
+	store.Set(types.CreationHeightKey(height, addr, name), []byte{})
+}
+
+func (k Keeper) removeCreationHeight(ctx sdk.Context, addr sdk.AccAddress, name string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentCreationHeightKeyPrefix)
+	store.Delete(types.CreationHeightKey(height, addr, name))
+}
+
+// IterateMetasByCreationHeight iterates over the metas ordered by creation height, then by deployment key, until the
+// callback returns true.
+func (k Keeper) IterateMetasByCreationHeight(ctx sdk.Context, reverse bool, cb func(meta types.Meta) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentCreationHeightKeyPrefix)
+
+	var iterator sdk.Iterator
+	if reverse {
+		iterator = store.ReverseIterator(nil, nil)
+	} else {
+		iterator = store.Iterator(nil, nil)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// Skip the 8 bytes of the creation height
+		addr, name, _, err := types.ParseDeploymentKey(iterator.Key()[8:])
+		if err != nil {
+			continue
+		}
+		meta, found := k.GetMeta(ctx, addr, name)
+		if !found {
+			continue
+		}
+		if cb(meta) {
+			return
+		}
+	}
+}
+
+// GetMetaByDomain returns the meta of the deployment serving the given domain.
+func (k Keeper) GetMetaByDomain(ctx sdk.Context, domain string) (meta types.Meta, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentDomainKeyPrefix)
+	b := store.Get(types.DomainKey(domain))
+	if b == nil {
+		return meta, false
+	}
+
+	return k.getMetaByDeploymentKey(ctx, b)
+}
+
 func (k Keeper) SetDataset(ctx sdk.Context, addr sdk.AccAddress, name string, dataset *types.Dataset) {
 	// NOTE: Safe to ignore the error here because the caller ensures that
 	for _, item := range dataset.GetItems() {
@@ -72,6 +172,18 @@ func (k Keeper) SetDataset(ctx sdk.Context, addr sdk.AccAddress, name string, da
 
 func (k Keeper) Remove(ctx sdk.Context, addr sdk.AccAddress, name string) {
 	k.RemoveDataset(ctx, addr, name)
+	k.RemoveRevisions(ctx, addr, name)
+
+	if meta, found := k.GetMeta(ctx, addr, name); found {
+		k.removeDomain(ctx, addr, name, meta.GetDomain())
+		k.removeCreationHeight(ctx, addr, name, meta.GetCreatedAtHeight())
+		k.removeSortKeys(ctx, addr, &meta)
+		k.removeExpiry(ctx, addr, name, meta.GetExpiresAtHeight())
+		k.removeDeploymentID(ctx, meta.GetId())
+	}
+	k.RemoveDomainClaim(ctx, addr, name)
+	k.RemoveTransfer(ctx, addr, name)
+	k.removeCollaborators(ctx, addr, name)
 
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentMetaKeyPrefix)
 	store.Delete(types.DeploymentKey(addr, name))
@@ -83,35 +195,174 @@ func (k Keeper) RemoveDataset(ctx sdk.Context, addr sdk.AccAddress, name string)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
+		var meta types.ItemMeta
+		k.cdc.MustUnmarshal(iterator.Value(), &meta)
+
+		k.releaseBlob(ctx, meta.GetHash())
 		store.Delete(iterator.Key())
 	}
+}
+
+func (k Keeper) SetItem(ctx sdk.Context, addr sdk.AccAddress, name string, item *types.Item) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+
+	meta := *item.GetMeta()
+	meta.Size_ = uint64(len(item.GetContent().GetContent()))
+	if meta.ContentType == "" {
+		meta.ContentType = types.DetectContentType(meta.GetPath(), item.GetContent().GetContent())
+	}
+	meta.Hash = k.retainBlob(ctx, &meta, item.GetContent().GetContent())
+
+	// Release the content of the replaced item, if any
+	key := types.DeploymentItemKey(addr, name, meta.GetPath())
+	if b := store.Get(key); b != nil {
+		var prev types.ItemMeta
+		k.cdc.MustUnmarshal(b, &prev)
+		k.releaseBlob(ctx, prev.GetHash())
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&meta))
+}
+
+// RemoveItem removes an item from a deployment. It returns false if the item does not exist.
+func (k Keeper) RemoveItem(ctx sdk.Context, addr sdk.AccAddress, name string, path string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+
+	key := types.DeploymentItemKey(addr, name, path)
+	b := store.Get(key)
+	if b == nil {
+		return false
+	}
+
+	var meta types.ItemMeta
+	k.cdc.MustUnmarshal(b, &meta)
+	k.releaseBlob(ctx, meta.GetHash())
+	store.Delete(key)
+
+	return true
+}
+
+// retainBlob stores the content, if not already stored, and increments its reference count. Compressible contents
+// are stored compressed, the item meta deciding whether the content is compressible. It returns the hash of the
+// original content.
+func (k Keeper) retainBlob(ctx sdk.Context, meta *types.ItemMeta, content []byte) []byte {
+	hash := types.ContentHash(content)
+
+	refCount := k.getBlobRefCount(ctx, hash)
+	if refCount == 0 {
+		encoded, encoding := types.EncodeContent(meta, content)
+
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobKeyPrefix)
+		// Empty files are stored as empty values, nil values are not allowed
+		store.Set(hash, append([]byte{}, encoded...))
+		if encoding != types.Encoding_ENCODING_IDENTITY {
+			// The content is compressed while executing the transaction, charge the write gas of the original content
+			// rather than of the smaller compressed one
+			ctx.GasMeter().ConsumeGas(ctx.KVGasConfig().WriteCostPerByte*uint64(len(content)-len(encoded)), "compressed content")
+			encodingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobEncodingKeyPrefix)
+			encodingStore.Set(hash, sdk.Uint64ToBigEndian(uint64(encoding)))
+		}
+	}
+	k.setBlobRefCount(ctx, hash, refCount+1)
+
+	return hash
+}
+
+// releaseBlob decrements the reference count of a content and deletes it once unreferenced.
+func (k Keeper) releaseBlob(ctx sdk.Context, hash []byte) {
+	refCount := k.getBlobRefCount(ctx, hash)
+	if refCount > 1 {
+		k.setBlobRefCount(ctx, hash, refCount-1)
+		return
+	}
+
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobRefCountKeyPrefix).Delete(hash)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobKeyPrefix).Delete(hash)
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobEncodingKeyPrefix).Delete(hash)
+}
+
+func (k Keeper) getBlobRefCount(ctx sdk.Context, hash []byte) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobRefCountKeyPrefix)
+	b := store.Get(hash)
+	if b == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(b)
+}
+
+func (k Keeper) setBlobRefCount(ctx sdk.Context, hash []byte, refCount uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobRefCountKeyPrefix)
+	store.Set(hash, sdk.Uint64ToBigEndian(refCount))
+}
+
+// GetBlob returns the content with the given hash, decoded. It returns an error if the stored content cannot be
+// decoded.
+func (k Keeper) GetBlob(ctx sdk.Context, hash []byte) (content []byte, found bool, err error) {
+	encoded, encoding, found := k.GetEncodedBlob(ctx, hash)
+	if !found {
+		return nil, false, nil
+	}
+
+	content, err = types.DecodeContent(encoded, encoding)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to decode blob %X: %w", hash, err)
+	}
+	return content, true, nil
+}
+
+// GetEncodedBlob returns the content with the given hash as stored, along with its encoding. The contents stored
+// before the module compressed them have no encoding entry: they stay stored as is, with the identity encoding.
+func (k Keeper) GetEncodedBlob(ctx sdk.Context, hash []byte) (content []byte, encoding types.Encoding, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobKeyPrefix)
+	if !store.Has(hash) {
+		return nil, types.Encoding_ENCODING_IDENTITY, false
+	}
+
+	encodingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlobEncodingKeyPrefix)
+	if b := encodingStore.Get(hash); b != nil {
+		encoding = types.Encoding(sdk.BigEndianToUint64(b))
+	}
+	return store.Get(hash), encoding, true
+}
 
-	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemContentPrefix)
-	iterator = sdk.KVStorePrefixIterator(store, types.DeploymentKey(addr, name))
+// GetBlobRefCount returns the number of items referencing the content with the given hash.
+func (k Keeper) GetBlobRefCount(ctx sdk.Context, hash []byte) uint64 {
+	return k.getBlobRefCount(ctx, hash)
+}
+
+// getItemMetas returns the item metas of a deployment, sorted by path.
+func (k Keeper) getItemMetas(ctx sdk.Context, addr sdk.AccAddress, name string) []*types.ItemMeta {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentKey(addr, name))
 	defer iterator.Close()
 
+	metas := make([]*types.ItemMeta, 0)
 	for ; iterator.Valid(); iterator.Next() {
-		store.Delete(iterator.Key())
+		var meta types.ItemMeta
+		k.cdc.MustUnmarshal(iterator.Value(), &meta)
+		metas = append(metas, &meta)
 	}
+
+	return metas
 }
 
-func (k Keeper) SetItem(ctx sdk.Context, addr sdk.AccAddress, name string, item *types.Item) {
-	// Set Item meta
+// GetItemSizes returns the content size of each item of a deployment, by path.
+func (k Keeper) GetItemSizes(ctx sdk.Context, addr sdk.AccAddress, name string) map[string]uint64 {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentKey(addr, name))
+	defer iterator.Close()
 
-	meta := item.GetMeta()
-	path := meta.GetPath()
-
-	b := k.cdc.MustMarshal(meta)
-	store.Set(types.DeploymentItemKey(addr, name, path), b)
+	sizes := make(map[string]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		var meta types.ItemMeta
+		k.cdc.MustUnmarshal(iterator.Value(), &meta)
+		sizes[meta.GetPath()] = meta.GetSize_()
+	}
 
-	// Set Item content
-	store = prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemContentPrefix)
-	b = k.cdc.MustMarshal(item.GetContent())
-	store.Set(types.DeploymentItemKey(addr, name, path), b)
+	return sizes
 }
 
-func (k Keeper) GetDataset(ctx sdk.Context, addr sdk.AccAddress, name string) (dataset *types.Dataset) {
+func (k Keeper) GetDataset(ctx sdk.Context, addr sdk.AccAddress, name string) (*types.Dataset, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
 	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentKey(addr, name))
 	defer iterator.Close()
@@ -121,24 +372,23 @@ func (k Keeper) GetDataset(ctx sdk.Context, addr sdk.AccAddress, name string) (d
 		var meta types.ItemMeta
 		k.cdc.MustUnmarshal(iterator.Value(), &meta)
 
-		store = prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemContentPrefix)
-		b := store.Get(types.DeploymentItemKey(addr, name, meta.GetPath()))
-		if b == nil {
+		content, found, err := k.GetBlob(ctx, meta.GetHash())
+		if err != nil {
+			return nil, err
+		}
+		if !found {
 			continue
 		}
 
-		var content types.ItemContent
-		k.cdc.MustUnmarshal(b, &content)
-
 		items = append(items, &types.Item{
 			Meta:    &meta,
-			Content: &content,
+			Content: &types.ItemContent{Content: content},
 		})
 	}
 
 	return &types.Dataset{
 		Items: items,
-	}
+	}, nil
 }
 
 func (k Keeper) GetMeta(ctx sdk.Context, addr sdk.AccAddress, name string) (meta types.Meta, found bool) {
@@ -152,21 +402,61 @@ func (k Keeper) GetMeta(ctx sdk.Context, addr sdk.AccAddress, name string) (meta
 	return meta, true
 }
 
-func (k Keeper) GetItemContent(ctx sdk.Context, addr sdk.AccAddress, name string, path string) (content types.ItemContent, found bool) {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemContentPrefix)
+// getItemMetaStore returns the store of the item metas of a deployment, keyed by path.
+func (k Keeper) getItemMetaStore(ctx sdk.Context, addr sdk.AccAddress, name string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+	return prefix.NewStore(store, types.DeploymentKey(addr, name))
+}
+
+func (k Keeper) GetItemMeta(ctx sdk.Context, addr sdk.AccAddress, name string, path string) (meta types.ItemMeta, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
 	b := store.Get(types.DeploymentItemKey(addr, name, path))
 	if b == nil {
-		return content, false
+		return meta, false
 	}
 
-	k.cdc.MustUnmarshal(b, &content)
-	return content, true
+	k.cdc.MustUnmarshal(b, &meta)
+	return meta, true
+}
+
+func (k Keeper) GetItemContent(ctx sdk.Context, addr sdk.AccAddress, name string, path string) (content types.ItemContent, found bool, err error) {
+	meta, found := k.GetItemMeta(ctx, addr, name, path)
+	if !found {
+		return content, false, nil
+	}
+
+	b, found, err := k.GetBlob(ctx, meta.GetHash())
+	if err != nil || !found {
+		return content, found, err
+	}
+
+	return types.ItemContent{Content: b}, true, nil
 }
 
 func (k Keeper) getDeploymentMetaStore(ctx sdk.Context) prefix.Store {
 	return prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentMetaKeyPrefix)
 }
 
+// getCreatorMetaStore returns the meta store of the deployments of a creator.
+func (k Keeper) getCreatorMetaStore(ctx sdk.Context, addr sdk.AccAddress) prefix.Store {
+	return prefix.NewStore(k.getDeploymentMetaStore(ctx), types.CreatorKey(addr))
+}
+
+// GetAllMetaByCreator returns the metas of all the deployments of a creator.
+func (k Keeper) GetAllMetaByCreator(ctx sdk.Context, addr sdk.AccAddress) (metas []*types.Meta) {
+	iterator := k.getCreatorMetaStore(ctx, addr).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var meta types.Meta
+		k.cdc.MustUnmarshal(iterator.Value(), &meta)
+
+		metas = append(metas, &meta)
+	}
+
+	return metas
+}
+
 func (k Keeper) GetAllMeta(ctx sdk.Context) (metas []*types.Meta) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentMetaKeyPrefix)
@@ -181,3 +471,40 @@ func (k Keeper) GetAllMeta(ctx sdk.Context) (metas []*types.Meta) {
 
 	return metas
 }
+
+func (k Keeper) SetDomainClaim(ctx sdk.Context, addr sdk.AccAddress, claim *types.DomainClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DomainClaimKeyPrefix)
+	b := k.cdc.MustMarshal(claim)
+	store.Set(types.DeploymentKey(addr, claim.GetName()), b)
+}
+
+func (k Keeper) GetDomainClaim(ctx sdk.Context, addr sdk.AccAddress, name string) (claim types.DomainClaim, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DomainClaimKeyPrefix)
+	b := store.Get(types.DeploymentKey(addr, name))
+	if b == nil {
+		return claim, false
+	}
+
+	k.cdc.MustUnmarshal(b, &claim)
+	return claim, true
+}
+
+func (k Keeper) RemoveDomainClaim(ctx sdk.Context, addr sdk.AccAddress, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DomainClaimKeyPrefix)
+	store.Delete(types.DeploymentKey(addr, name))
+}
+
+func (k Keeper) GetAllDomainClaims(ctx sdk.Context) (claims []*types.DomainClaim) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DomainClaimKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var claim types.DomainClaim
+		k.cdc.MustUnmarshal(iterator.Value(), &claim)
+
+		claims = append(claims, &claim)
+	}
+
+	return claims
+}
@@ -1,12 +1,16 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -48,3 +52,244 @@ func TestSet_GetAllMeta(t *testing.T) {
 	require.Len(t, all, keepertest.NUM_DEPLOYMENT)
 	require.ElementsMatch(t, metas, all)
 }
+
+func TestSet_GetMetaByDomain(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE)
+	require.Len(t, metas, keepertest.NUM_DEPLOYMENT)
+
+	for _, meta := range metas {
+		retrievedMeta, found := k.GetMetaByDomain(ctx, meta.GetDomain())
+		require.True(t, found)
+		require.Equal(t, meta, &retrievedMeta)
+	}
+}
+
+func TestSet_GetMetaByDomainUpdate(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, 1, keepertest.DATASET_SIZE)
+	meta := metas[0]
+	creator := sdk.MustAccAddressFromBech32(meta.GetCreator())
+	oldDomain := meta.GetDomain()
+
+	meta.Domain = "Example.COM"
+	k.SetMeta(ctx, creator, meta)
+
+	_, found := k.GetMetaByDomain(ctx, oldDomain)
+	require.False(t, found)
+
+	retrievedMeta, found := k.GetMetaByDomain(ctx, "example.com.")
+	require.True(t, found)
+	require.Equal(t, meta, &retrievedMeta)
+
+	meta.Domain = ""
+	k.SetMeta(ctx, creator, meta)
+	_, found = k.GetMetaByDomain(ctx, "example.com")
+	require.False(t, found)
+}
+
+func TestRemove_GetMetaByDomain(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE)
+
+	for _, meta := range metas {
+		k.Remove(ctx, sdk.MustAccAddressFromBech32(meta.GetCreator()), meta.GetName())
+
+		_, found := k.GetMetaByDomain(ctx, meta.GetDomain())
+		require.False(t, found)
+	}
+}
+
+func TestRemove_SharedNamePrefix(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	item := func(path string) *types.Item {
+		return &types.Item{
+			Meta:    &types.ItemMeta{Path: path, Hash: types.ContentHash([]byte(path)), Size_: uint64(len(path)), ContentType: "text/plain; charset=utf-8"},
+			Content: &types.ItemContent{Content: []byte(path)},
+		}
+	}
+	k.SetDeployment(ctx, addr, &types.Meta{Creator: addr.String(), Name: "a"}, &types.Dataset{Items: []*types.Item{item("bc")}})
+	k.SetDeployment(ctx, addr, &types.Meta{Creator: addr.String(), Name: "ab"}, &types.Dataset{Items: []*types.Item{item("c")}})
+
+	content, found, err := k.GetItemContent(ctx, addr, "a", "bc")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("bc"), content.Content)
+	dataset, err := k.GetDataset(ctx, addr, "a")
+	require.NoError(t, err)
+	require.Len(t, dataset.Items, 1)
+
+	k.Remove(ctx, addr, "a")
+
+	dataset, err = k.GetDataset(ctx, addr, "a")
+	require.NoError(t, err)
+	require.Empty(t, dataset.Items)
+	dataset, err = k.GetDataset(ctx, addr, "ab")
+	require.NoError(t, err)
+	require.Equal(t, []*types.Item{item("c")}, dataset.Items)
+}
+
+func TestSet_GetAllMetaByCreator(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	addr := sample.AccAddress()
+	metas, _ := keepertest.CreateAndSetNDeploymentsWithAddr(ctx, k, keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE, addr)
+	keepertest.CreateAndSetNDeployments(ctx, k, keepertest.NUM_DEPLOYMENT, keepertest.DATASET_SIZE)
+
+	all := k.GetAllMetaByCreator(ctx, sdk.MustAccAddressFromBech32(addr))
+	require.ElementsMatch(t, metas, all)
+}
+
+func TestSet_IterateMetasByCreationHeight(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, datasets := sample.CreateNMetaDataset(3, keepertest.DATASET_SIZE)
+	heights := []int64{20, 10, 30}
+	for i, meta := range metas {
+		meta.CreatedAtHeight = heights[i]
+		k.SetDeployment(ctx, sdk.MustAccAddressFromBech32(meta.Creator), meta, datasets[i])
+	}
+
+	var got []*types.Meta
+	k.IterateMetasByCreationHeight(ctx, false, func(meta types.Meta) bool {
+		got = append(got, &meta)
+		return false
+	})
+	require.Equal(t, []*types.Meta{metas[1], metas[0], metas[2]}, got)
+
+	got = nil
+	k.IterateMetasByCreationHeight(ctx, true, func(meta types.Meta) bool {
+		got = append(got, &meta)
+		return len(got) == 2
+	})
+	require.Equal(t, []*types.Meta{metas[2], metas[0]}, got)
+
+	k.Remove(ctx, sdk.MustAccAddressFromBech32(metas[0].Creator), metas[0].Name)
+	got = nil
+	k.IterateMetasByCreationHeight(ctx, false, func(meta types.Meta) bool {
+		got = append(got, &meta)
+		return false
+	})
+	require.Equal(t, []*types.Meta{metas[1], metas[2]}, got)
+}
+
+func TestSetItem_DeduplicatedContent(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, datasets := sample.CreateNMetaDataset(2, keepertest.DATASET_SIZE)
+	for i, meta := range metas {
+		k.SetDeployment(ctx, sdk.MustAccAddressFromBech32(meta.Creator), meta, datasets[i])
+	}
+
+	// Both deployments share the same contents
+	for _, item := range datasets[0].Items {
+		require.Equal(t, uint64(2), k.GetBlobRefCount(ctx, item.Meta.Hash))
+	}
+
+	k.Remove(ctx, sdk.MustAccAddressFromBech32(metas[0].Creator), metas[0].Name)
+	for _, item := range datasets[0].Items {
+		require.Equal(t, uint64(1), k.GetBlobRefCount(ctx, item.Meta.Hash))
+		content, found, err := k.GetBlob(ctx, item.Meta.Hash)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, item.Content.Content, content)
+	}
+
+	k.Remove(ctx, sdk.MustAccAddressFromBech32(metas[1].Creator), metas[1].Name)
+	for _, item := range datasets[0].Items {
+		require.Zero(t, k.GetBlobRefCount(ctx, item.Meta.Hash))
+		_, found, err := k.GetBlob(ctx, item.Meta.Hash)
+		require.NoError(t, err)
+		require.False(t, found)
+	}
+}
+
+func TestSetItem_ReplaceContent(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	metas, datasets := keepertest.CreateAndSetNDeployments(ctx, k, 1, keepertest.DATASET_SIZE)
+	addr := sdk.MustAccAddressFromBech32(metas[0].Creator)
+	old := datasets[0].Items[0]
+
+	k.SetItem(ctx, addr, metas[0].Name, &types.Item{Meta: &types.ItemMeta{Path: old.Meta.Path}, Content: &types.ItemContent{Content: []byte("new")}})
+
+	_, found, err := k.GetBlob(ctx, old.Meta.Hash)
+	require.NoError(t, err)
+	require.False(t, found)
+	content, found, err := k.GetItemContent(ctx, addr, metas[0].Name, old.Meta.Path)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, []byte("new"), content.Content)
+	require.Equal(t, uint64(1), k.GetBlobRefCount(ctx, types.ContentHash([]byte("new"))))
+
+	// Setting the same content twice keeps a single reference
+	k.SetItem(ctx, addr, metas[0].Name, &types.Item{Meta: &types.ItemMeta{Path: old.Meta.Path}, Content: &types.ItemContent{Content: []byte("new")}})
+	require.Equal(t, uint64(1), k.GetBlobRefCount(ctx, types.ContentHash([]byte("new"))))
+}
+
+func TestSetItem_EmptyContent(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	k.SetItem(ctx, addr, "foo", &types.Item{Meta: &types.ItemMeta{Path: "empty"}, Content: &types.ItemContent{}})
+
+	content, found, err := k.GetItemContent(ctx, addr, "foo", "empty")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Empty(t, content.Content)
+}
+
+func TestSetItem_CorruptContent(t *testing.T) {
+	k, ctx, storeKey := keepertest.GhostcloudKeeperWithStoreKey(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	content := []byte("not brotli")
+	k.SetItem(ctx, addr, "foo", &types.Item{
+		Meta:    &types.ItemMeta{Path: "index.html", Hash: types.ContentHash(content), Size_: uint64(len(content))},
+		Content: &types.ItemContent{Content: content},
+	})
+	encodingStore := prefix.NewStore(ctx.KVStore(storeKey), types.BlobEncodingKeyPrefix)
+	encodingStore.Set(types.ContentHash(content), sdk.Uint64ToBigEndian(uint64(types.Encoding_ENCODING_BROTLI)))
+
+	// Decoding failures are returned instead of panicking
+	_, _, err := k.GetBlob(ctx, types.ContentHash(content))
+	require.ErrorContains(t, err, "failed to decode blob")
+	_, _, err = k.GetItemContent(ctx, addr, "foo", "index.html")
+	require.ErrorContains(t, err, "failed to decode blob")
+	_, err = k.GetDataset(ctx, addr, "foo")
+	require.ErrorContains(t, err, "failed to decode blob")
+}
+
+func TestGetBlob_LegacyContent(t *testing.T) {
+	k, ctx, storeKey := keepertest.GhostcloudKeeperWithStoreKey(t)
+
+	// Contents stored before compression have no encoding entry
+	content := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+	hash := types.ContentHash(content)
+	prefix.NewStore(ctx.KVStore(storeKey), types.BlobKeyPrefix).Set(hash, content)
+
+	encoded, encoding, found := k.GetEncodedBlob(ctx, hash)
+	require.True(t, found)
+	require.Equal(t, types.Encoding_ENCODING_IDENTITY, encoding)
+	require.Equal(t, content, encoded)
+
+	decoded, found, err := k.GetBlob(ctx, hash)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, content, decoded)
+}
+
+func TestSetItem_CompressedContentGas(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	content := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+
+	// The write gas of a compressed content is charged on its original size
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.SetItem(ctx, addr, "foo", &types.Item{
+		Meta:    &types.ItemMeta{Path: "index.html"},
+		Content: &types.ItemContent{Content: content},
+	})
+	_, encoding, found := k.GetEncodedBlob(ctx, types.ContentHash(content))
+	require.True(t, found)
+	require.Equal(t, types.Encoding_ENCODING_BROTLI, encoding)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), ctx.KVGasConfig().WriteCostPerByte*uint64(len(content)))
+}
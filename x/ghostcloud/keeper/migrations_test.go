@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/exported"
+	"ghostcloud/x/ghostcloud/keeper"
+	v2 "ghostcloud/x/ghostcloud/migrations/v2"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// emptySubspace is a legacy params subspace without params.
+type emptySubspace struct{}
+
+func (emptySubspace) GetParamSetIfExists(sdk.Context, exported.ParamSet) {}
+
+func TestMigrate1to2DomainIndex(t *testing.T) {
+	k, ctx, storeKey := testkeeper.GhostcloudKeeperWithStoreKey(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	legacyMetaStore := prefix.NewStore(ctx.KVStore(storeKey), v2.LegacyDeploymentMetaKeyPrefix)
+
+	// Deployments created before the domain index, two of them sharing the same domain
+	metas := []*types.Meta{
+		{Creator: sample.AccAddress(), Name: "foo", Domain: "Example.com"},
+		{Creator: sample.AccAddress(), Name: "bar", Domain: "example.com."},
+		{Creator: sample.AccAddress(), Name: "baz", Domain: "baz.com"},
+	}
+	for _, meta := range metas {
+		addr := sdk.MustAccAddressFromBech32(meta.GetCreator())
+		legacyMetaStore.Set(v2.LegacyDeploymentKey(addr, meta.GetName()), cdc.MustMarshal(meta))
+	}
+
+	require.NoError(t, keeper.NewMigrator(*k, emptySubspace{}).Migrate1to2(ctx))
+
+	// The first deployment in key order wins the shared domain
+	winner, loser := metas[0], metas[1]
+	if bytes.Compare(sdk.MustAccAddressFromBech32(loser.GetCreator()), sdk.MustAccAddressFromBech32(winner.GetCreator())) < 0 {
+		winner, loser = loser, winner
+	}
+
+	response, err := k.DeploymentByDomain(wctx, &types.QueryDeploymentByDomainRequest{Domain: "example.com"})
+	require.NoError(t, err)
+	require.Equal(t, winner.GetCreator(), response.GetMeta().GetCreator())
+	require.Equal(t, winner.GetName(), response.GetMeta().GetName())
+
+	response, err = k.DeploymentByDomain(wctx, &types.QueryDeploymentByDomainRequest{Domain: "baz.com"})
+	require.NoError(t, err)
+	require.Equal(t, metas[2].GetCreator(), response.GetMeta().GetCreator())
+	require.Equal(t, "baz", response.GetMeta().GetName())
+
+	meta, found := k.GetMeta(ctx, sdk.MustAccAddressFromBech32(loser.GetCreator()), loser.GetName())
+	require.True(t, found)
+	require.Empty(t, meta.GetDomain())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/asaskevich/govalidator"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -28,54 +29,9 @@ var _ types.MsgServer = msgServer{}
 
 const InvalidCreatorAddr = "invalid creator address: %s"
 
-func datasetFromZip(content []byte) (*types.Dataset, error) {
-	r := bytes.NewReader(content)
-	zipReader, err := zip.NewReader(r, int64(len(content)))
-	if err != nil {
-		return nil, fmt.Errorf("zip reader error: %w", err)
-	}
-
-	items := make([]*types.Item, 0, len(zipReader.File))
-	for _, file := range zipReader.File {
-		ferr := func(f *zip.File) error {
-			rc, oerr := file.Open()
-			if oerr != nil {
-				return fmt.Errorf("error opening file: %w", oerr)
-			}
-			defer rc.Close()
-
-			content, rerr := io.ReadAll(rc)
-			if rerr != nil {
-				return fmt.Errorf("error reading file: %w", rerr)
-			}
-
-			items = append(items, &types.Item{
-				Meta:    &types.ItemMeta{Path: file.Name},
-				Content: &types.ItemContent{Content: content},
-			})
-			return nil
-		}(file)
-		if ferr != nil {
-			return nil, fmt.Errorf("error processing file: %w", ferr)
-		}
-	}
-
-	return &types.Dataset{
-		Items: items,
-	}, nil
-}
-func datasetFromArchive(archive *types.Archive) (*types.Dataset, error) {
-	switch archive.Type {
-	case types.ArchiveType_Zip:
-		return datasetFromZip(archive.Content)
-	default:
-		return nil, fmt.Errorf("unsupported archive type: %s", archive.Type)
-	}
-}
-
 func HandlePayload(payload *types.Payload) (*types.Dataset, error) {
 	if archive := payload.GetArchive(); archive != nil {
-		return datasetFromArchive(archive)
+		return types.DatasetFromArchive(archive)
 	} else if dataset := payload.GetDataset(); dataset != nil {
 		return dataset, nil
 	}
@@ -83,6 +39,15 @@ func HandlePayload(payload *types.Payload) (*types.Dataset, error) {
 	return nil, fmt.Errorf("unsupported payload type")
 }
 
+// wrapInvalidRequest wraps a validation error as an invalid request. Item path errors are returned as-is, so that
+// clients can tell them apart.
+func wrapInvalidRequest(err error) error {
+	if types.IsPathError(err) {
+		return err
+	}
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+}
+
 func validateCreator(creator string) error {
 	if creator == "" {
 		return fmt.Errorf(types.CreatorShouldNotBeEmpty)
@@ -123,6 +88,19 @@ func validateDomain(domain string) error {
 	return nil
 }
 
+// checkDomainAvailable returns an error if the domain is served by another deployment. The domain index covers all
+// the deployments, the v2 migration indexing those created before it.
+func (k msgServer) checkDomainAvailable(ctx sdk.Context, addr sdk.AccAddress, name string, domain string) error {
+	if domain == "" {
+		return nil
+	}
+	meta, found := k.GetMetaByDomain(ctx, domain)
+	if found && (meta.GetCreator() != addr.String() || meta.GetName() != name) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.DomainAlreadyInUse, domain)
+	}
+	return nil
+}
+
 func validateMeta(meta *types.Meta, params types.Params) error {
 	if meta == nil {
 		return fmt.Errorf(types.MetaIsRequired)
@@ -139,6 +117,9 @@ func validateMeta(meta *types.Meta, params types.Params) error {
 	if err := validateDomain(meta.Domain); err != nil {
 		return err
 	}
+	if meta.ExpiresAtHeight < 0 {
+		return fmt.Errorf(types.ExpiryHeightIsNegative, meta.ExpiresAtHeight)
+	}
 
 	return nil
 }
@@ -154,8 +135,8 @@ func validatePayload(payload *types.Payload, params types.Params) error {
 		if archive == nil {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "archive cannot be nil")
 		}
-		if err := verifyArchiveContent(archive.Content, params.MaxUncompressedSize); err != nil {
-			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+		if err := verifyArchiveContent(archive, params.MaxUncompressedSize); err != nil {
+			return wrapInvalidRequest(err)
 		}
 	case *types.Payload_Dataset:
 		dataset := payload.GetDataset()
@@ -163,13 +144,26 @@ func validatePayload(payload *types.Payload, params types.Params) error {
 			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "dataset cannot be nil")
 		}
 		if err := verifyDatasetContent(dataset); err != nil {
-			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, err.Error())
+			return wrapInvalidRequest(err)
 		}
 	}
 	return nil
 }
 
-func verifyArchiveContent(archive []byte, maxUncompressedSize uint64) error {
+// verifyArchiveContent verifies the paths of the files of an archive, that it holds an `index.html` file and that
+// its total uncompressed size does not exceed the maximum, without extracting it.
+func verifyArchiveContent(archive *types.Archive, maxUncompressedSize uint64) error {
+	switch archive.Type {
+	case types.ArchiveType_Zip:
+		return verifyZipContent(archive.Content, maxUncompressedSize)
+	case types.ArchiveType_TarGz, types.ArchiveType_TarZstd:
+		return verifyTarContent(archive, maxUncompressedSize)
+	default:
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "unsupported archive type: %s", archive.Type)
+	}
+}
+
+func verifyZipContent(archive []byte, maxUncompressedSize uint64) error {
 	r := bytes.NewReader(archive)
 	zipReader, err := zip.NewReader(r, int64(len(archive)))
 	if err != nil {
@@ -178,16 +172,77 @@ func verifyArchiveContent(archive []byte, maxUncompressedSize uint64) error {
 
 	var totalUncompressedSize uint64
 	var indexFound bool
+	paths := make(map[string]struct{})
 	for _, file := range zipReader.File {
 		if file.FileInfo().IsDir() {
 			continue
 		}
+		path, err := types.CanonicalPath(file.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		paths[path] = struct{}{}
+
 		totalUncompressedSize += file.UncompressedSize64
 		if totalUncompressedSize > maxUncompressedSize {
 			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.UncompressedSizeTooBig, totalUncompressedSize, maxUncompressedSize)
 		}
 
-		if file.Name == "index.html" {
+		if path == "index.html" {
+			indexFound = true
+		}
+	}
+
+	if !indexFound {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, types.IndexHtmlNotFound)
+	}
+
+	return nil
+}
+
+func verifyTarContent(archive *types.Archive, maxUncompressedSize uint64) error {
+	// The tarball headers and padding are bounded as well, so that archives of many empty files cannot be used as bombs
+	tarReader, closer, err := types.NewTarReader(archive, 2*maxUncompressedSize)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	defer closer.Close()
+
+	var totalUncompressedSize uint64
+	var indexFound bool
+	paths := make(map[string]struct{})
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		if ok, err := types.IsTarFile(header); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		} else if !ok {
+			continue
+		}
+
+		path, err := types.CanonicalPath(header.Name)
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		paths[path] = struct{}{}
+
+		totalUncompressedSize += uint64(header.Size)
+		if totalUncompressedSize > maxUncompressedSize {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.UncompressedSizeTooBig, totalUncompressedSize, maxUncompressedSize)
+		}
+
+		if path == "index.html" {
 			indexFound = true
 		}
 	}
@@ -199,12 +254,31 @@ func verifyArchiveContent(archive []byte, maxUncompressedSize uint64) error {
 	return nil
 }
 
+// verifyDatasetContent verifies the paths and metas of the items of a dataset, and replaces their paths by their
+// canonical form.
 func verifyDatasetContent(dataset *types.Dataset) error {
 	var indexFound bool
+	paths := make(map[string]struct{})
 	for _, item := range dataset.Items {
-		if item.Meta.Path == "index.html" {
+		if item.GetMeta() == nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item meta cannot be nil")
+		}
+		path, err := types.CanonicalPath(item.Meta.Path)
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		paths[path] = struct{}{}
+		item.Meta.Path = path
+
+		if path == "index.html" {
 			indexFound = true
 		}
+		if err := types.ValidateItemMeta(item.Meta); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
 	}
 
 	if !indexFound {
@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func validateCollaboratorRequest(signer string, creator string, name string, params types.Params) error {
+	if signer == "" {
+		return fmt.Errorf(types.InvalidSignerAddress, "empty address")
+	}
+	if err := validateCreator(creator); err != nil {
+		return err
+	}
+	if err := validateName(name, params.MaxNameSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateAddCollaboratorRequest(msg *types.MsgAddCollaboratorRequest, params types.Params) error {
+	if err := validateCollaboratorRequest(msg.Signer, msg.Creator, msg.Name, params); err != nil {
+		return err
+	}
+	if _, ok := types.CollaboratorRole_name[int32(msg.Role)]; !ok || msg.Role == types.CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED {
+		return fmt.Errorf(types.InvalidCollaboratorRole, msg.Role)
+	}
+	return nil
+}
+
+// getCollaboratorAddresses returns the creator and collaborator addresses of a collaborator request, verifying that
+// the deployment exists and that the signer is allowed to manage its collaborators.
+func (k msgServer) getCollaboratorAddresses(ctx sdk.Context, signer string, creator string, name string, collaborator string) (sdk.AccAddress, sdk.AccAddress, error) {
+	addr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+	collaboratorAddr, err := sdk.AccAddressFromBech32(collaborator)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, types.InvalidCollaboratorAddress, err)
+	}
+	if collaboratorAddr.Equals(addr) {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, types.CollaboratorIsCreator)
+	}
+
+	if !k.HasDeployment(ctx, addr, name) {
+		return nil, nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to manage the collaborators of a non-existing deployment")
+	}
+	if err := k.authorize(ctx, addr, name, signer, types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN); err != nil {
+		return nil, nil, err
+	}
+
+	return addr, collaboratorAddr, nil
+}
+
+// AddCollaborator grants a role on a deployment to an address, or changes the role of an existing collaborator.
+// The signer must be the creator or an admin of the deployment.
+func (k msgServer) AddCollaborator(goCtx context.Context, msg *types.MsgAddCollaboratorRequest) (*types.MsgAddCollaboratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateAddCollaboratorRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, collaboratorAddr, err := k.getCollaboratorAddresses(ctx, msg.Signer, msg.Creator, msg.Name, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetCollaborator(ctx, addr, &types.Collaborator{
+		Creator: msg.Creator,
+		Name:    msg.Name,
+		Address: collaboratorAddr.String(),
+		Role:    msg.Role,
+	})
+
+	return &types.MsgAddCollaboratorResponse{}, nil
+}
+
+// RemoveCollaborator revokes the role of a collaborator on a deployment. The signer must be the creator or an admin
+// of the deployment.
+func (k msgServer) RemoveCollaborator(goCtx context.Context, msg *types.MsgRemoveCollaboratorRequest) (*types.MsgRemoveCollaboratorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateCollaboratorRequest(msg.Signer, msg.Creator, msg.Name, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, collaboratorAddr, err := k.getCollaboratorAddresses(ctx, msg.Signer, msg.Creator, msg.Name, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, found := k.GetCollaborator(ctx, addr, msg.Name, collaboratorAddr); !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, types.CollaboratorNotFound, msg.Address)
+	}
+	k.Keeper.RemoveCollaborator(ctx, addr, msg.Name, collaboratorAddr)
+
+	return &types.MsgRemoveCollaboratorResponse{}, nil
+}
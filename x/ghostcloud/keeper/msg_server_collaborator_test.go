@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestDeploymentMsgServerCollaborators(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress("owner")
+	admin := sdk.AccAddress("admin")
+	editor := sdk.AccAddress("editor")
+	stranger := sdk.AccAddress("stranger")
+	meta := &types.Meta{Creator: owner.String(), Name: "foo"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+
+	// The creator adds an admin, who adds an editor
+	_, err = srv.AddCollaborator(wctx, &types.MsgAddCollaboratorRequest{
+		Signer: owner.String(), Creator: owner.String(), Name: meta.Name, Address: admin.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN,
+	})
+	require.NoError(t, err)
+	_, err = srv.AddCollaborator(wctx, &types.MsgAddCollaboratorRequest{
+		Signer: admin.String(), Creator: owner.String(), Name: meta.Name, Address: editor.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR,
+	})
+	require.NoError(t, err)
+
+	// Editors cannot manage collaborators
+	_, err = srv.AddCollaborator(wctx, &types.MsgAddCollaboratorRequest{
+		Signer: editor.String(), Creator: owner.String(), Name: meta.Name, Address: stranger.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Editors can update and patch the files of the deployment
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{
+		Meta:    &types.Meta{Creator: owner.String(), Name: meta.Name},
+		Payload: createIndexPayload("v2"),
+		Signer:  editor.String(),
+	})
+	require.NoError(t, err)
+	content, found, err := k.GetItemContent(ctx, owner, meta.Name, "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "v2", string(content.Content))
+
+	_, err = srv.PatchDeployment(wctx, &types.MsgPatchDeploymentRequest{
+		Creator: owner.String(),
+		Name:    meta.Name,
+		Upsert:  &types.Dataset{Items: []*types.Item{newItem("app.js", "app")}},
+		Signer:  editor.String(),
+	})
+	require.NoError(t, err)
+	_, found = k.GetItemMeta(ctx, owner, meta.Name, "app.js")
+	require.True(t, found)
+
+	// Other addresses cannot
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: meta, Signer: stranger.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.PatchDeployment(wctx, &types.MsgPatchDeploymentRequest{
+		Creator: owner.String(), Name: meta.Name, Delete: []string{"app.js"}, Signer: stranger.String(),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// Removed collaborators lose their role
+	_, err = srv.RemoveCollaborator(wctx, &types.MsgRemoveCollaboratorRequest{
+		Signer: admin.String(), Creator: owner.String(), Name: meta.Name, Address: editor.String(),
+	})
+	require.NoError(t, err)
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: meta, Signer: editor.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RemoveCollaborator(wctx, &types.MsgRemoveCollaboratorRequest{
+		Signer: admin.String(), Creator: owner.String(), Name: meta.Name, Address: editor.String(),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// Collaborators are removed with the deployment
+	_, err = srv.RemoveDeployment(wctx, &types.MsgRemoveDeploymentRequest{Creator: owner.String(), Name: meta.Name})
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllCollaborators(ctx))
+}
+
+func TestDeploymentMsgServerCollaboratorMetaChanges(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress("owner")
+	admin := sdk.AccAddress("admin")
+	editor := sdk.AccAddress("editor")
+	meta := &types.Meta{Creator: owner.String(), Name: "foo", Domain: "foo.com"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+	for addr, role := range map[string]types.CollaboratorRole{
+		admin.String():  types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN,
+		editor.String(): types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR,
+	} {
+		_, err = srv.AddCollaborator(wctx, &types.MsgAddCollaboratorRequest{
+			Signer: owner.String(), Creator: owner.String(), Name: meta.Name, Address: addr, Role: role,
+		})
+		require.NoError(t, err)
+	}
+
+	// Editors cannot change the domain or the description
+	for _, changed := range []*types.Meta{
+		{Creator: owner.String(), Name: meta.Name, Domain: "bar.com"},
+		{Creator: owner.String(), Name: meta.Name, Domain: meta.Domain, Description: "edited"},
+	} {
+		_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: changed, Signer: editor.String()})
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	}
+	_, found := k.GetMetaByDomain(ctx, "bar.com")
+	require.False(t, found)
+
+	// Admins and the creator can
+	changed := &types.Meta{Creator: owner.String(), Name: meta.Name, Domain: "bar.com", Description: "edited"}
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: changed, Signer: admin.String()})
+	require.NoError(t, err)
+	updated, found := k.GetMeta(ctx, owner, meta.Name)
+	require.True(t, found)
+	require.Equal(t, "bar.com", updated.Domain)
+	require.Equal(t, "edited", updated.Description)
+
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: meta})
+	require.NoError(t, err)
+	updated, found = k.GetMeta(ctx, owner, meta.Name)
+	require.True(t, found)
+	require.Equal(t, "foo.com", updated.Domain)
+	require.Empty(t, updated.Description)
+}
+
+func TestDeploymentMsgServerCollaboratorErrors(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress("owner")
+	editor := sdk.AccAddress("editor")
+	meta := &types.Meta{Creator: owner.String(), Name: "foo"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		msg  *types.MsgAddCollaboratorRequest
+		err  error
+	}{
+		{
+			name: "creator as collaborator",
+			msg:  &types.MsgAddCollaboratorRequest{Signer: owner.String(), Creator: owner.String(), Name: meta.Name, Address: owner.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "unspecified role",
+			msg:  &types.MsgAddCollaboratorRequest{Signer: owner.String(), Creator: owner.String(), Name: meta.Name, Address: editor.String()},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "non-existing deployment",
+			msg:  &types.MsgAddCollaboratorRequest{Signer: owner.String(), Creator: owner.String(), Name: "bar", Address: editor.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid collaborator address",
+			msg:  &types.MsgAddCollaboratorRequest{Signer: owner.String(), Creator: owner.String(), Name: meta.Name, Address: "invalid", Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			name: "unauthorized signer",
+			msg:  &types.MsgAddCollaboratorRequest{Signer: editor.String(), Creator: owner.String(), Name: meta.Name, Address: editor.String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.AddCollaborator(wctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -28,7 +28,7 @@ func (k msgServer) CreateDeployment(goCtx context.Context, msg *types.MsgCreateD
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := k.GetParams(ctx)
 	if err := validateCreateDeploymentRequest(msg, params); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return nil, wrapInvalidRequest(err)
 	}
 
 	addr, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
@@ -36,20 +36,44 @@ func (k msgServer) CreateDeployment(goCtx context.Context, msg *types.MsgCreateD
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
 	}
 
-	if k.HasDeployment(ctx, addr, msg.Meta.Name) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
-	}
-
 	dataset, err := HandlePayload(msg.Payload)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return nil, wrapInvalidRequest(err)
 	}
 
-	k.SetDeployment(
-		ctx,
-		addr,
-		msg.Meta,
-		dataset,
-	)
+	if err := k.createDeployment(ctx, addr, msg.Meta, dataset); err != nil {
+		return nil, err
+	}
 	return &types.MsgCreateDeploymentResponse{}, nil
 }
+
+// createDeployment stores a new deployment.
+func (k msgServer) createDeployment(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta, dataset *types.Dataset) error {
+	if k.HasDeployment(ctx, addr, meta.Name) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+	}
+
+	if err := k.checkDomainAvailable(ctx, addr, meta.Name, meta.Domain); err != nil {
+		return err
+	}
+
+	if meta.ExpiresAtHeight != 0 && meta.ExpiresAtHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.ExpiryHeightNotInFuture, meta.ExpiresAtHeight, ctx.BlockHeight())
+	}
+
+	// Domains are only verified through a domain claim
+	meta.DomainVerified = false
+	meta.CreatedAtHeight = ctx.BlockHeight()
+	meta.CreatedAtTime = ctx.BlockTime()
+	meta.Version = 0
+	touchMeta(ctx, meta)
+	meta.Id = k.NextDeploymentID(ctx)
+	meta.Deposit = nil
+	k.SetDeployment(ctx, addr, meta, dataset)
+	k.RecordRevision(ctx, addr, meta.Name)
+	if err := k.settleDeposit(ctx, addr, meta); err != nil {
+		return err
+	}
+
+	return k.emitDeploymentCreated(ctx, addr, meta)
+}
@@ -311,6 +311,39 @@ func testDeploymentMsgCreateServerInvalidDomain(t *testing.T, k *keeper.Keeper,
 	testDeploymentMsgServerCreate(t, k, ctx, tc)
 }
 
+func testDeploymentMsgCreateServerArchiveDuplicatePath(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+	meta, payload := sample.CreateArchivePayload(1)
+	payload.GetArchive().Content = sample.CreateZipWithFiles(
+		sample.ArchiveFile{Name: "index.html", Body: sample.HelloWorldHTMLBody},
+		sample.ArchiveFile{Name: "./index.html", Body: sample.HelloWorldHTMLBody},
+	)
+	tc := keepertest.MsgServerTestCase{
+		Name:     "archive_duplicate_path",
+		Metas:    []*types.Meta{meta},
+		Payloads: []*types.Payload{payload},
+		Err:      types.ErrDuplicatePath,
+	}
+	testDeploymentMsgServerCreate(t, k, ctx, tc)
+}
+
+func testDeploymentMsgCreateServerNestedArchive(t *testing.T, k *keeper.Keeper, ctx sdk.Context) {
+	meta, payload := sample.CreateArchivePayload(2)
+	payload.GetArchive().Content = sample.CreateZipWithFiles(
+		sample.ArchiveFile{Name: "./index.html", Body: sample.HelloWorldHTMLBody},
+		sample.ArchiveFile{Name: "assets/app.js", Body: "assets"},
+		sample.ArchiveFile{Name: "lib/app.js", Body: "lib"},
+	)
+	tc := keepertest.MsgServerTestCase{
+		Name:     "nested_archive",
+		Metas:    []*types.Meta{meta},
+		Payloads: []*types.Payload{payload},
+	}
+	testDeploymentMsgServerCreate(t, k, ctx, tc)
+
+	addr := sdk.MustAccAddressFromBech32(meta.Creator)
+	require.Equal(t, map[string]uint64{"index.html": uint64(len(sample.HelloWorldHTMLBody)), "assets/app.js": 6, "lib/app.js": 3}, k.GetItemSizes(ctx, addr, meta.Name))
+}
+
 func TestDeploymentMsgServerCreate(t *testing.T) {
 	k, ctx := keepertest.GhostcloudKeeper(t)
 
@@ -335,4 +368,173 @@ func TestDeploymentMsgServerCreate(t *testing.T) {
 	testDeploymentMsgCreateServerNameHasWhitespace(t, k, ctx)
 	testDeploymentMsgCreateServerNameAsciiOnly(t, k, ctx)
 	testDeploymentMsgCreateServerInvalidDomain(t, k, ctx)
+	testDeploymentMsgCreateServerArchiveDuplicatePath(t, k, ctx)
+	testDeploymentMsgCreateServerNestedArchive(t, k, ctx)
+}
+
+func TestDeploymentMsgServerCreatePaths(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+
+	newDataset := func(paths ...string) *types.Payload {
+		items := make([]*types.Item, 0, len(paths))
+		for _, path := range paths {
+			items = append(items, newItem(path, path))
+		}
+		return &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: items}}}
+	}
+
+	tests := []struct {
+		name    string
+		payload *types.Payload
+		err     error
+	}{
+		{name: "traversal", payload: newDataset("index.html", "../secret"), err: types.ErrPathTraversal},
+		{name: "absolute", payload: newDataset("index.html", "/etc/passwd"), err: types.ErrAbsolutePath},
+		{name: "backslash", payload: newDataset("index.html", `assets\app.js`), err: types.ErrPathBackslash},
+		{name: "segment", payload: newDataset("index.html", "assets//app.js"), err: types.ErrEmptyPathSegment},
+		{name: "nul_byte", payload: newDataset("index.html", "app\x00.js"), err: types.ErrPathNulByte},
+		{name: "empty", payload: newDataset("index.html", ""), err: types.ErrEmptyPath},
+		{name: "duplicate", payload: newDataset("index.html", "./index.html"), err: types.ErrDuplicatePath},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			meta := &types.Meta{Creator: addr.String(), Name: tc.name}
+			_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: tc.payload})
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// Accepted paths are stored in their canonical form
+	meta := &types.Meta{Creator: addr.String(), Name: "canonical"}
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta:    meta,
+		Payload: newDataset("./index.html", "assets/./app.js"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"index.html": 12, "assets/app.js": 15}, k.GetItemSizes(ctx, addr, meta.Name))
+}
+
+func TestDeploymentMsgServerCreateTarball(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	params := k.GetParams(ctx)
+
+	newPayload := func(archiveType types.ArchiveType, files ...sample.ArchiveFile) *types.Payload {
+		return &types.Payload{PayloadOption: &types.Payload_Archive{Archive: &types.Archive{
+			Type:    archiveType,
+			Content: sample.CreateTarballWithFiles(archiveType, files...),
+		}}}
+	}
+
+	for _, archiveType := range []types.ArchiveType{types.ArchiveType_TarGz, types.ArchiveType_TarZstd} {
+		t.Run(archiveType.String(), func(t *testing.T) {
+			tests := []struct {
+				name    string
+				payload *types.Payload
+				err     string
+			}{
+				{
+					name: "valid",
+					payload: newPayload(archiveType,
+						sample.ArchiveFile{Name: "./index.html", Body: sample.HelloWorldHTMLBody},
+						sample.ArchiveFile{Name: "./assets/"},
+						sample.ArchiveFile{Name: "./assets/app.js", Body: "assets"},
+					),
+				},
+				{
+					name:    "no_index",
+					payload: newPayload(archiveType, sample.ArchiveFile{Name: "foobar.html", Body: sample.HelloWorldHTMLBody}),
+					err:     types.IndexHtmlNotFound,
+				},
+				{
+					name: "bomb",
+					payload: newPayload(archiveType,
+						sample.ArchiveFile{Name: "index.html", Body: strings.Repeat("a", int(params.MaxUncompressedSize)+1)},
+					),
+					err: fmt.Sprintf(types.UncompressedSizeTooBig, params.MaxUncompressedSize+1, params.MaxUncompressedSize),
+				},
+				{
+					name:    "traversal",
+					payload: newPayload(archiveType, sample.ArchiveFile{Name: "../index.html", Body: sample.HelloWorldHTMLBody}),
+					err:     types.ErrPathTraversal.Error(),
+				},
+			}
+			for _, tc := range tests {
+				t.Run(tc.name, func(t *testing.T) {
+					meta := &types.Meta{Creator: addr.String(), Name: fmt.Sprintf("%s%d", tc.name, archiveType)}
+					_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: tc.payload})
+					if tc.err != "" {
+						require.ErrorContains(t, err, tc.err)
+						return
+					}
+					require.NoError(t, err)
+					require.Equal(t, map[string]uint64{"index.html": uint64(len(sample.HelloWorldHTMLBody)), "assets/app.js": 6}, k.GetItemSizes(ctx, addr, meta.Name))
+				})
+			}
+		})
+	}
+}
+
+func TestDeploymentMsgServerCreateTarballHeaderBomb(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.MaxUncompressedSize = 4096
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Empty files do not count towards the uncompressed size, but their headers do
+	files := []sample.ArchiveFile{{Name: "index.html"}}
+	for i := 0; i < 16; i++ {
+		files = append(files, sample.ArchiveFile{Name: fmt.Sprintf("%d.txt", i)})
+	}
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta: &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo"},
+		Payload: &types.Payload{PayloadOption: &types.Payload_Archive{Archive: &types.Archive{
+			Type:    types.ArchiveType_TarGz,
+			Content: sample.CreateTarballWithFiles(types.ArchiveType_TarGz, files...),
+		}}},
+	})
+	require.ErrorContains(t, err, "total uncompressed size is too big")
+}
+
+func TestDeploymentMsgServerCreateCreatedHeight(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	ctx = ctx.WithBlockHeight(42)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	meta, payload := sample.CreateDatasetPayloadWithIndexHtml(0, keepertest.DATASET_SIZE)
+	meta.CreatedAtHeight = 1
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.NoError(t, err)
+
+	storeMeta, found := k.GetMeta(ctx, sdk.MustAccAddressFromBech32(meta.Creator), meta.Name)
+	require.True(t, found)
+	require.Equal(t, int64(42), storeMeta.CreatedAtHeight)
+	require.Equal(t, int64(42), storeMeta.UpdatedAtHeight)
+	var totalSize uint64
+	for _, item := range payload.GetDataset().GetItems() {
+		totalSize += uint64(len(item.GetContent().GetContent()))
+	}
+	require.Equal(t, totalSize, storeMeta.TotalSize)
+}
+
+func TestDeploymentMsgServerCreateEvent(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	meta := &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo", Domain: "foo.com"}
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+
+	requireTypedEvent(t, ctx, &types.EventDeploymentCreated{
+		Creator:     meta.Creator,
+		Name:        meta.Name,
+		Domain:      meta.Domain,
+		Revision:    1,
+		DatasetHash: types.DatasetHash([]*types.ItemMeta{{Path: "index.html", Hash: types.ContentHash([]byte("v1"))}}),
+	})
 }
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func datasetPayload(items ...*types.Item) *types.Payload {
+	return &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: items}}}
+}
+
+func stake(amount int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin("stake", amount))
+}
+
+func TestRequiredDeposit(t *testing.T) {
+	deposit, err := keeper.RequiredDeposit(nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, deposit)
+	deposit, err = keeper.RequiredDeposit(stake(2), 0)
+	require.NoError(t, err)
+	require.Nil(t, deposit)
+	deposit, err = keeper.RequiredDeposit(stake(2), 10)
+	require.NoError(t, err)
+	require.Equal(t, stake(20), deposit)
+
+	// Overflowing deposits are rejected rather than waived
+	_, err = keeper.RequiredDeposit(hugeStake(), math.MaxUint64)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "deposit overflow")
+}
+
+// hugeStake returns a deposit per byte close to the maximum coin amount.
+func hugeStake() sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin("stake", sdkmath.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 255))))
+}
+
+func TestDeploymentMsgServerDeposit(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := k.GetParams(ctx)
+	params.DepositPerByte = stake(2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+
+	// 10 bytes
+	meta := &types.Meta{Creator: addr.String(), Name: "foo"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{
+		Meta:    meta,
+		Payload: datasetPayload(newItem("index.html", "0123456789")),
+	})
+	require.NoError(t, err)
+	requireDeposit(t, k, ctx, addr, stake(20))
+	require.Equal(t, stake(80), bank.Balance(addr))
+	require.Equal(t, stake(20), bank.ModuleBalance(types.ModuleName))
+
+	// 15 bytes
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{
+		Meta:    meta,
+		Payload: datasetPayload(newItem("index.html", "0123456789"), newItem("app.js", "01234")),
+	})
+	require.NoError(t, err)
+	requireDeposit(t, k, ctx, addr, stake(30))
+	require.Equal(t, stake(70), bank.Balance(addr))
+
+	// 10 bytes, the previous revision still retains the 5 bytes of the deleted file
+	_, err = srv.PatchDeployment(wctx, &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Delete: []string{"app.js"}})
+	require.NoError(t, err)
+	requireDeposit(t, k, ctx, addr, stake(30))
+	require.Equal(t, stake(70), bank.Balance(addr))
+
+	// 10 bytes, the revisions retaining the deleted file are pruned
+	params.MaxRevisions = 1
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = srv.PatchDeployment(wctx, &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Upsert: &types.Dataset{Items: []*types.Item{newItem("index.html", "9876543210")}}})
+	require.NoError(t, err)
+	requireDeposit(t, k, ctx, addr, stake(20))
+	require.Equal(t, stake(80), bank.Balance(addr))
+
+	// The deposit is refunded on removal
+	_, err = srv.RemoveDeployment(wctx, &types.MsgRemoveDeploymentRequest{Creator: meta.Creator, Name: meta.Name})
+	require.NoError(t, err)
+	require.Equal(t, stake(100), bank.Balance(addr))
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+}
+
+func TestDeploymentMsgServerDepositOverflow(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.DepositPerByte = hugeStake()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta:    &types.Meta{Creator: addr.String(), Name: "foo"},
+		Payload: datasetPayload(newItem("index.html", "0123456789")),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "deposit overflow")
+	require.Equal(t, stake(100), bank.Balance(addr))
+}
+
+func TestDeploymentMsgServerDepositInsufficientFunds(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.DepositPerByte = stake(2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(19))
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta:    &types.Meta{Creator: addr.String(), Name: "foo"},
+		Payload: datasetPayload(newItem("index.html", "0123456789")),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, stake(19), bank.Balance(addr))
+}
+
+func requireDeposit(t *testing.T, k *keeper.Keeper, ctx sdk.Context, addr sdk.AccAddress, expected sdk.Coins) {
+	meta, found := k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, expected, meta.Deposit)
+}
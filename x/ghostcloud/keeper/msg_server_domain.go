@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func validateClaimDomainRequest(msg *types.MsgClaimDomainRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	if msg.Domain == "" {
+		return fmt.Errorf(types.DomainIsRequired)
+	}
+	if err := validateDomain(msg.Domain); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ClaimDomain records a pending claim of a deployment on a domain and returns the challenge token to publish in the
+// DNS records of the domain. The domain is assigned to the deployment once the claim is verified.
+func (k msgServer) ClaimDomain(goCtx context.Context, msg *types.MsgClaimDomainRequest) (*types.MsgClaimDomainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateClaimDomainRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	if !k.HasDeployment(ctx, addr, msg.Name) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to claim a domain for a non-existing deployment")
+	}
+
+	claim := &types.DomainClaim{
+		Creator: msg.Creator,
+		Name:    msg.Name,
+		Domain:  types.NormalizeDomain(msg.Domain),
+		Token:   types.DomainClaimToken(msg.Creator, msg.Name, msg.Domain, ctx.BlockHeight()),
+	}
+	k.SetDomainClaim(ctx, addr, claim)
+
+	return &types.MsgClaimDomainResponse{Token: claim.Token}, nil
+}
+
+func validateVerifyDomainRequest(msg *types.MsgVerifyDomainRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	if msg.Domain == "" {
+		return fmt.Errorf(types.DomainIsRequired)
+	}
+	return nil
+}
+
+// VerifyDomain assigns the domain of a verified claim to its deployment and marks it as verified. The domain is removed
+// from any other deployment serving it.
+func (k msgServer) VerifyDomain(goCtx context.Context, msg *types.MsgVerifyDomainRequest) (*types.MsgVerifyDomainResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, types.InvalidAuthority, k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateVerifyDomainRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	claim, found := k.GetDomainClaim(ctx, addr, msg.Name)
+	if !found || claim.GetDomain() != types.NormalizeDomain(msg.Domain) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, types.DomainClaimNotFound, msg.Domain)
+	}
+
+	meta, found := k.GetMeta(ctx, addr, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to verify a domain for a non-existing deployment")
+	}
+
+	// Evict the deployment currently serving the domain, if any
+	if current, found := k.GetMetaByDomain(ctx, claim.GetDomain()); found {
+		currentAddr := sdk.MustAccAddressFromBech32(current.GetCreator())
+		current.Domain = ""
+		current.DomainVerified = false
+		touchMeta(ctx, &current)
+		k.SetMeta(ctx, currentAddr, &current)
+		if err := k.emitDeploymentUpdated(ctx, currentAddr, &current, k.getItemMetas(ctx, currentAddr, current.Name)); err != nil {
+			return nil, err
+		}
+	}
+
+	meta.Domain = claim.GetDomain()
+	meta.DomainVerified = true
+	touchMeta(ctx, &meta)
+	k.SetMeta(ctx, addr, &meta)
+	k.RemoveDomainClaim(ctx, addr, msg.Name)
+	if err := k.emitDeploymentUpdated(ctx, addr, &meta, k.getItemMetas(ctx, addr, meta.Name)); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgVerifyDomainResponse{}, nil
+}
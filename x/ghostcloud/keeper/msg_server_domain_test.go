@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/keeper"
+	v2 "ghostcloud/x/ghostcloud/migrations/v2"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const testDomain = "example.com"
+
+func TestDeploymentMsgServerCreateDomainInUse(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, 1, keepertest.DATASET_SIZE)
+
+	meta, payload := sample.CreateDatasetPayloadWithIndexHtml(0, keepertest.DATASET_SIZE)
+	meta.Domain = metas[0].Domain
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "domain already in use")
+
+	// Domains are case-insensitive
+	meta.Domain = "EXAMPLE." + metas[0].Domain
+	_, err = srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.NoError(t, err)
+}
+
+func TestDeploymentMsgServerCreateLegacyDomainInUse(t *testing.T) {
+	k, ctx, storeKey := keepertest.GhostcloudKeeperWithStoreKey(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Deployment created before the domain index
+	legacy := &types.Meta{Creator: sample.AccAddress(), Name: "legacy", Domain: testDomain}
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	prefix.NewStore(ctx.KVStore(storeKey), v2.LegacyDeploymentMetaKeyPrefix).
+		Set(v2.LegacyDeploymentKey(sdk.MustAccAddressFromBech32(legacy.GetCreator()), legacy.GetName()), cdc.MustMarshal(legacy))
+	require.NoError(t, keeper.NewMigrator(*k, emptySubspace{}).Migrate1to2(ctx))
+
+	meta, payload := sample.CreateDatasetPayloadWithIndexHtml(0, keepertest.DATASET_SIZE)
+	meta.Domain = testDomain
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "domain already in use")
+}
+
+func TestDeploymentMsgServerUpdateDomainInUse(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, 2, keepertest.DATASET_SIZE)
+
+	meta := *metas[1]
+	meta.Domain = metas[0].Domain
+	_, err := srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: &meta})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "domain already in use")
+
+	// Keeping the same domain is allowed
+	meta = *metas[0]
+	meta.Description = "new description"
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: &meta})
+	require.NoError(t, err)
+}
+
+func TestDeploymentMsgServerClaimDomain(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, 1, keepertest.DATASET_SIZE)
+	creator := sdk.MustAccAddressFromBech32(metas[0].Creator)
+
+	res, err := srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: metas[0].Creator, Name: metas[0].Name, Domain: testDomain})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Token)
+
+	claim, found := k.GetDomainClaim(ctx, creator, metas[0].Name)
+	require.True(t, found)
+	require.Equal(t, testDomain, claim.Domain)
+	require.Equal(t, res.Token, claim.Token)
+
+	_, err = srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: metas[0].Creator, Name: "missing", Domain: testDomain})
+	require.ErrorContains(t, err, "non-existing deployment")
+
+	_, err = srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: metas[0].Creator, Name: metas[0].Name, Domain: "invalid domain"})
+	require.ErrorContains(t, err, "invalid domain")
+
+	_, err = srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: metas[0].Creator, Name: metas[0].Name})
+	require.ErrorContains(t, err, types.DomainIsRequired)
+
+	// The claim is removed with the deployment
+	k.Remove(ctx, creator, metas[0].Name)
+	_, found = k.GetDomainClaim(ctx, creator, metas[0].Name)
+	require.False(t, found)
+}
+
+func TestDeploymentMsgServerVerifyDomain(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	metas, _ := keepertest.CreateAndSetNDeployments(ctx, k, 2, keepertest.DATASET_SIZE)
+	squatter, owner := metas[0], metas[1]
+	squatter.Domain = testDomain
+	k.SetMeta(ctx, sdk.MustAccAddressFromBech32(squatter.Creator), squatter)
+
+	verify := &types.MsgVerifyDomainRequest{
+		Authority: k.GetAuthority(),
+		Creator:   owner.Creator,
+		Name:      owner.Name,
+		Domain:    testDomain,
+	}
+
+	// No claim yet
+	_, err := srv.VerifyDomain(wctx, verify)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: owner.Creator, Name: owner.Name, Domain: testDomain})
+	require.NoError(t, err)
+
+	// Only the authority can verify a claim
+	_, err = srv.VerifyDomain(wctx, &types.MsgVerifyDomainRequest{Authority: owner.Creator, Creator: owner.Creator, Name: owner.Name, Domain: testDomain})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The domain must match the claim
+	_, err = srv.VerifyDomain(wctx, &types.MsgVerifyDomainRequest{Authority: k.GetAuthority(), Creator: owner.Creator, Name: owner.Name, Domain: "other.com"})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = srv.VerifyDomain(wctx, verify)
+	require.NoError(t, err)
+
+	meta, found := k.GetMetaByDomain(ctx, testDomain)
+	require.True(t, found)
+	require.Equal(t, owner.Creator, meta.Creator)
+	require.Equal(t, owner.Name, meta.Name)
+	require.True(t, meta.DomainVerified)
+
+	// The squatter lost the domain
+	meta, found = k.GetMeta(ctx, sdk.MustAccAddressFromBech32(squatter.Creator), squatter.Name)
+	require.True(t, found)
+	require.Empty(t, meta.Domain)
+	require.False(t, meta.DomainVerified)
+
+	// The claim is consumed
+	_, found = k.GetDomainClaim(ctx, sdk.MustAccAddressFromBech32(owner.Creator), owner.Name)
+	require.False(t, found)
+}
+
+func TestDeploymentMsgServerDomainVerifiedReset(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	// Domains set on create are not verified
+	meta, payload := sample.CreateDatasetPayloadWithIndexHtml(0, keepertest.DATASET_SIZE)
+	meta.Domain = testDomain
+	meta.DomainVerified = true
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.NoError(t, err)
+	addr := sdk.MustAccAddressFromBech32(meta.Creator)
+	got, found := k.GetMeta(ctx, addr, meta.Name)
+	require.True(t, found)
+	require.False(t, got.DomainVerified)
+
+	_, err = srv.ClaimDomain(wctx, &types.MsgClaimDomainRequest{Creator: meta.Creator, Name: meta.Name, Domain: testDomain})
+	require.NoError(t, err)
+	_, err = srv.VerifyDomain(wctx, &types.MsgVerifyDomainRequest{Authority: k.GetAuthority(), Creator: meta.Creator, Name: meta.Name, Domain: testDomain})
+	require.NoError(t, err)
+
+	// Keeping the domain keeps its verification
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: meta.Creator, Name: meta.Name, Description: "new", Domain: "EXAMPLE.com"}})
+	require.NoError(t, err)
+	got, _ = k.GetMeta(ctx, addr, meta.Name)
+	require.True(t, got.DomainVerified)
+
+	// Changing the domain resets it
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: meta.Creator, Name: meta.Name, Domain: "other.com"}})
+	require.NoError(t, err)
+	got, _ = k.GetMeta(ctx, addr, meta.Name)
+	require.Equal(t, "other.com", got.Domain)
+	require.False(t, got.DomainVerified)
+}
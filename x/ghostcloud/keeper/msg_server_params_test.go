@@ -0,0 +1,44 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgServerUpdateParams(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := types.DefaultParams()
+	params.MaxNameSize = 4
+	params.MaxRevisions = 3
+
+	_, err := srv.UpdateParams(wctx, &types.MsgUpdateParamsRequest{Authority: sample.AccAddress(), Params: params})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	invalid := params
+	invalid.UploadSessionTtl = 0
+	_, err = srv.UpdateParams(wctx, &types.MsgUpdateParamsRequest{Authority: k.GetAuthority(), Params: invalid})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = srv.UpdateParams(wctx, &types.MsgUpdateParamsRequest{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	// The new params apply to the following messages
+	meta, payload := sample.CreateDatasetPayloadWithIndexHtml(0, keepertest.DATASET_SIZE)
+	meta.Name = "toolong"
+	_, err = srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: payload})
+	require.ErrorContains(t, err, "name is too long")
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validatePatchDeploymentRequest validates a patch request, and replaces its paths by their canonical form.
+func validatePatchDeploymentRequest(msg *types.MsgPatchDeploymentRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	if len(msg.GetUpsert().GetItems()) == 0 && len(msg.GetDelete()) == 0 {
+		return fmt.Errorf(types.NothingToUpdate)
+	}
+	if int64(msg.GetUpsert().Size()) > params.MaxPayloadSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.PayloadTooBig, msg.GetUpsert().Size(), params.MaxPayloadSize)
+	}
+
+	// A path can only be changed once per patch. Paths are replaced by their canonical form.
+	paths := make(map[string]struct{})
+	for _, item := range msg.GetUpsert().GetItems() {
+		if item.GetMeta() == nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item meta cannot be nil")
+		}
+		path, err := types.CanonicalPath(item.GetMeta().GetPath())
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		if err := types.ValidateItemMeta(item.GetMeta()); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		paths[path] = struct{}{}
+		item.Meta.Path = path
+	}
+	for i, p := range msg.GetDelete() {
+		path, err := types.CanonicalPath(p)
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		paths[path] = struct{}{}
+		msg.Delete[i] = path
+	}
+
+	return nil
+}
+
+// verifyPatchedDataset verifies that the deployment still holds an `index.html` file and fits in the maximum
+// uncompressed size once the patch is applied.
+func (k msgServer) verifyPatchedDataset(ctx sdk.Context, addr sdk.AccAddress, msg *types.MsgPatchDeploymentRequest, params types.Params) error {
+	sizes := k.GetItemSizes(ctx, addr, msg.Name)
+
+	for _, path := range msg.GetDelete() {
+		if _, ok := sizes[path]; !ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.ItemNotFound, path)
+		}
+		delete(sizes, path)
+	}
+	for _, item := range msg.GetUpsert().GetItems() {
+		sizes[item.GetMeta().GetPath()] = uint64(len(item.GetContent().GetContent()))
+	}
+
+	if _, ok := sizes["index.html"]; !ok {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, types.IndexHtmlNotFound)
+	}
+
+	var totalSize uint64
+	for _, size := range sizes {
+		totalSize += size
+	}
+	if totalSize > params.MaxUncompressedSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.UncompressedSizeTooBig, totalSize, params.MaxUncompressedSize)
+	}
+
+	return nil
+}
+
+// PatchDeployment adds, replaces and deletes individual files of a deployment. The signer must be the creator, or an
+// editor or an admin of the deployment.
+func (k msgServer) PatchDeployment(goCtx context.Context, msg *types.MsgPatchDeploymentRequest) (*types.MsgPatchDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validatePatchDeploymentRequest(msg, params); err != nil {
+		return nil, wrapInvalidRequest(err)
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+	if err := k.authorize(ctx, addr, msg.Name, msg.SignerAddress(), types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR); err != nil {
+		return nil, err
+	}
+
+	meta, found := k.GetMeta(ctx, addr, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to patch a non-existing deployment")
+	}
+
+	if err := k.verifyPatchedDataset(ctx, addr, msg, params); err != nil {
+		return nil, err
+	}
+
+	before := k.getItemMetas(ctx, addr, msg.Name)
+	for _, path := range msg.GetDelete() {
+		k.RemoveItem(ctx, addr, msg.Name, path)
+	}
+	for _, item := range msg.GetUpsert().GetItems() {
+		k.SetItem(ctx, addr, msg.Name, item)
+	}
+	k.RecordRevision(ctx, addr, msg.Name)
+	touchMeta(ctx, &meta)
+	if err := k.settleDeposit(ctx, addr, &meta); err != nil {
+		return nil, err
+	}
+
+	if err := k.emitDeploymentUpdated(ctx, addr, &meta, before); err != nil {
+		return nil, err
+	}
+	return &types.MsgPatchDeploymentResponse{}, nil
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newItem(path string, content string) *types.Item {
+	return &types.Item{Meta: &types.ItemMeta{Path: path}, Content: &types.ItemContent{Content: []byte(content)}}
+}
+
+func setupPatchDeployment(t *testing.T) (*keeper.Keeper, sdk.Context, *types.Meta) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	meta := &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo"}
+	k.SetDeployment(ctx, sdk.AccAddress("creator"), meta, &types.Dataset{Items: []*types.Item{
+		newItem("index.html", "<h1>index</h1>"),
+		newItem("style.css", "body {}"),
+		newItem("app.js", "console.log()"),
+	}})
+	return k, ctx, meta
+}
+
+func TestDeploymentMsgServerPatch(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	ctx = ctx.WithBlockHeight(7)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+
+	_, err := srv.PatchDeployment(sdk.WrapSDKContext(ctx), &types.MsgPatchDeploymentRequest{
+		Creator: meta.Creator,
+		Name:    meta.Name,
+		Upsert: &types.Dataset{Items: []*types.Item{
+			newItem("style.css", "body { color: red; }"),
+			newItem("docs/index.html", "<h1>docs</h1>"),
+		}},
+		Delete: []string{"./app.js"},
+	})
+	require.NoError(t, err)
+
+	revision, found := k.GetRevision(ctx, addr, meta.Name, 1)
+	require.True(t, found)
+	requireTypedEvent(t, ctx, &types.EventDeploymentUpdated{
+		Creator:      meta.Creator,
+		Name:         meta.Name,
+		Revision:     1,
+		DatasetHash:  revision.Hash,
+		ChangedPaths: []string{"app.js", "docs/index.html", "style.css"},
+	})
+
+	sizes := k.GetItemSizes(ctx, addr, meta.Name)
+	require.Len(t, sizes, 3)
+	require.NotContains(t, sizes, "app.js")
+
+	content, found, err := k.GetItemContent(ctx, addr, meta.Name, "style.css")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "body { color: red; }", string(content.Content))
+
+	content, found, err = k.GetItemContent(ctx, addr, meta.Name, "docs/index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "<h1>docs</h1>", string(content.Content))
+
+	content, found, err = k.GetItemContent(ctx, addr, meta.Name, "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "<h1>index</h1>", string(content.Content))
+
+	storeMeta, found := k.GetMeta(ctx, addr, meta.Name)
+	require.True(t, found)
+	require.Equal(t, int64(7), storeMeta.UpdatedAtHeight)
+	require.Equal(t, uint64(len("<h1>index</h1>")+len("body { color: red; }")+len("<h1>docs</h1>")), storeMeta.TotalSize)
+}
+
+func TestDeploymentMsgServerPatchInvalid(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	params := k.GetParams(ctx)
+
+	tests := []struct {
+		name string
+		msg  *types.MsgPatchDeploymentRequest
+		err  string
+	}{
+		{
+			name: "nothing_to_update",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name},
+			err:  types.NothingToUpdate,
+		},
+		{
+			name: "non_existing",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: "bar", Delete: []string{"app.js"}},
+			err:  "non-existing deployment",
+		},
+		{
+			name: "delete_index",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Delete: []string{"index.html"}},
+			err:  types.IndexHtmlNotFound,
+		},
+		{
+			name: "delete_missing",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Delete: []string{"missing.js"}},
+			err:  "item not found",
+		},
+		{
+			name: "duplicate_path",
+			msg: &types.MsgPatchDeploymentRequest{
+				Creator: meta.Creator,
+				Name:    meta.Name,
+				Upsert:  &types.Dataset{Items: []*types.Item{newItem("app.js", "")}},
+				Delete:  []string{"app.js"},
+			},
+			err: "duplicate path",
+		},
+		{
+			name: "traversal",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Delete: []string{"../app.js"}},
+			err:  types.ErrPathTraversal.Error(),
+		},
+		{
+			name: "duplicate_canonical_path",
+			msg: &types.MsgPatchDeploymentRequest{
+				Creator: meta.Creator,
+				Name:    meta.Name,
+				Upsert:  &types.Dataset{Items: []*types.Item{newItem("app.js", "")}},
+				Delete:  []string{"./app.js"},
+			},
+			err: "duplicate path",
+		},
+		{
+			name: "invalid_cache_control",
+			msg: &types.MsgPatchDeploymentRequest{
+				Creator: meta.Creator,
+				Name:    meta.Name,
+				Upsert: &types.Dataset{Items: []*types.Item{{
+					Meta:    &types.ItemMeta{Path: "app.js", CacheControl: "no-cache\r\nX-Foo: bar"},
+					Content: &types.ItemContent{Content: []byte("console.log()")},
+				}}},
+			},
+			err: "invalid cache control",
+		},
+		{
+			name: "payload_too_big",
+			msg: &types.MsgPatchDeploymentRequest{
+				Creator: meta.Creator,
+				Name:    meta.Name,
+				Upsert:  &types.Dataset{Items: []*types.Item{newItem("big.bin", string(make([]byte, params.MaxPayloadSize)))}},
+			},
+			err: "payload is too big",
+		},
+		{
+			name: "empty_name",
+			msg:  &types.MsgPatchDeploymentRequest{Creator: meta.Creator, Delete: []string{"app.js"}},
+			err:  types.NameShouldNotBeEmpty,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.PatchDeployment(sdk.WrapSDKContext(ctx), tc.msg)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+
+	// The deployment is left untouched
+	require.Len(t, k.GetItemSizes(ctx, sdk.AccAddress("creator"), meta.Name), 3)
+}
+
+func TestDeploymentMsgServerPatchUncompressedTooBig(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.MaxUncompressedSize = 64
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := srv.PatchDeployment(sdk.WrapSDKContext(ctx), &types.MsgPatchDeploymentRequest{
+		Creator: meta.Creator,
+		Name:    meta.Name,
+		Upsert:  &types.Dataset{Items: []*types.Item{newItem("big.bin", string(make([]byte, 64)))}},
+	})
+	require.ErrorContains(t, err, "uncompressed size is too big")
+}
+
+func TestDeploymentMsgServerPatchItemMeta(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+
+	_, err := srv.PatchDeployment(sdk.WrapSDKContext(ctx), &types.MsgPatchDeploymentRequest{
+		Creator: meta.Creator,
+		Name:    meta.Name,
+		Upsert: &types.Dataset{Items: []*types.Item{
+			{
+				Meta:    &types.ItemMeta{Path: "feed", ContentType: "application/rss+xml", CacheControl: "no-cache"},
+				Content: &types.ItemContent{Content: []byte("<rss></rss>")},
+			},
+			{
+				Meta:    &types.ItemMeta{Path: "app.js.gz", ContentType: "text/javascript", ContentEncoding: "gzip"},
+				Content: &types.ItemContent{Content: []byte{0x1f, 0x8b}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+
+	// Provided content types and headers are stored as is
+	itemMeta, found := k.GetItemMeta(ctx, addr, meta.Name, "feed")
+	require.True(t, found)
+	require.Equal(t, "application/rss+xml", itemMeta.ContentType)
+	require.Equal(t, "no-cache", itemMeta.CacheControl)
+	require.Empty(t, itemMeta.ContentEncoding)
+
+	itemMeta, found = k.GetItemMeta(ctx, addr, meta.Name, "app.js.gz")
+	require.True(t, found)
+	require.Equal(t, "text/javascript", itemMeta.ContentType)
+	require.Equal(t, "gzip", itemMeta.ContentEncoding)
+
+	// Missing content types are detected
+	itemMeta, found = k.GetItemMeta(ctx, addr, meta.Name, "style.css")
+	require.True(t, found)
+	require.Equal(t, "text/css; charset=utf-8", itemMeta.ContentType)
+	require.Empty(t, itemMeta.CacheControl)
+}
@@ -41,7 +41,13 @@ func (k msgServer) RemoveDeployment(goCtx context.Context, msg *types.MsgRemoveD
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized")
 	}
 
+	if err := k.refundDeposit(ctx, addr, &meta); err != nil {
+		return nil, err
+	}
 	k.Remove(ctx, addr, msg.Name)
+	if err := emitDeploymentRemoved(ctx, msg.Creator, msg.Name); err != nil {
+		return nil, err
+	}
 
 	return &types.MsgRemoveDeploymentResponse{}, nil
 }
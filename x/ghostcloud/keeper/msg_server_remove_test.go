@@ -96,3 +96,13 @@ func TestDeploymentMsgServerRemove(t *testing.T) {
 	testDeploymentMsgServerRemoveInvalidCreator(t, k, ctx)
 	testDeploymentMsgServerRemoveNonExisting(t, k, ctx)
 }
+
+func TestDeploymentMsgServerRemoveEvent(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	_, err := srv.RemoveDeployment(sdk.WrapSDKContext(ctx), &types.MsgRemoveDeploymentRequest{Creator: meta.Creator, Name: meta.Name})
+	require.NoError(t, err)
+
+	requireTypedEvent(t, ctx, &types.EventDeploymentRemoved{Creator: meta.Creator, Name: meta.Name})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func validateRenewDeploymentRequest(msg *types.MsgRenewDeploymentRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RenewDeployment extends the expiry of a deployment. The renewal fee of each additional block is paid by the creator
+// to the fee collector.
+func (k msgServer) RenewDeployment(goCtx context.Context, msg *types.MsgRenewDeploymentRequest) (*types.MsgRenewDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateRenewDeploymentRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	meta, found := k.GetMeta(ctx, addr, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to renew a non-existing deployment")
+	}
+	if meta.ExpiresAtHeight == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, types.DeploymentDoesNotExpire)
+	}
+	if msg.ExpiresAtHeight <= meta.ExpiresAtHeight {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.ExpiryHeightNotExtended, msg.ExpiresAtHeight, meta.ExpiresAtHeight)
+	}
+
+	fee, err := RenewalFee(params.RenewalFeePerBlock, msg.ExpiresAtHeight-meta.ExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, fee); err != nil {
+			return nil, errorsmod.Wrap(err, "unable to pay the renewal fee")
+		}
+	}
+
+	meta.ExpiresAtHeight = msg.ExpiresAtHeight
+	k.SetMeta(ctx, addr, &meta)
+
+	if err := emitDeploymentRenewed(ctx, &meta); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRenewDeploymentResponse{}, nil
+}
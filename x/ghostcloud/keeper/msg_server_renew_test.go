@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func createExpiringDeployment(t *testing.T, srv types.MsgServer, ctx sdk.Context, addr sdk.AccAddress, name string, expiresAtHeight int64) {
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta:    &types.Meta{Creator: addr.String(), Name: name, ExpiresAtHeight: expiresAtHeight},
+		Payload: datasetPayload(newItem("index.html", "0123456789")),
+	})
+	require.NoError(t, err)
+}
+
+func TestDeploymentMsgServerCreateExpiryInPast(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	ctx = ctx.WithBlockHeight(10)
+
+	for _, height := range []int64{-1, 5, 10} {
+		_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+			Meta:    &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo", ExpiresAtHeight: height},
+			Payload: datasetPayload(newItem("index.html", "0123456789")),
+		})
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	}
+}
+
+func TestDeploymentMsgServerRenew(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := k.GetParams(ctx)
+	params.RenewalFeePerBlock = stake(3)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+	createExpiringDeployment(t, srv, ctx, addr, "foo", 10)
+	createExpiringDeployment(t, srv, ctx, addr, "bar", 0)
+
+	tests := []struct {
+		name string
+		msg  *types.MsgRenewDeploymentRequest
+		err  error
+	}{
+		{
+			name: "non_existing",
+			msg:  &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "baz", ExpiresAtHeight: 20},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "does_not_expire",
+			msg:  &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "bar", ExpiresAtHeight: 20},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "not_extended",
+			msg:  &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 10},
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "insufficient_funds",
+			msg:  &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 100},
+			err:  sdkerrors.ErrInsufficientFunds,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := srv.RenewDeployment(wctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	_, err := srv.RenewDeployment(wctx, &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 20})
+	require.NoError(t, err)
+	meta, found := k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, int64(20), meta.ExpiresAtHeight)
+	require.Equal(t, stake(70), bank.Balance(addr))
+	require.Equal(t, stake(30), bank.ModuleBalance(authtypes.FeeCollectorName))
+	requireTypedEvent(t, ctx, &types.EventDeploymentRenewed{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 20})
+}
+
+func TestRenewalFee(t *testing.T) {
+	fee, err := keeper.RenewalFee(nil, 10)
+	require.NoError(t, err)
+	require.Nil(t, fee)
+	fee, err = keeper.RenewalFee(stake(3), 10)
+	require.NoError(t, err)
+	require.Equal(t, stake(30), fee)
+
+	// Overflowing fees are rejected rather than waived
+	_, err = keeper.RenewalFee(hugeStake(), 2)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "renewal fee overflow")
+}
+
+func TestDeploymentMsgServerRenewFeeOverflow(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+	createExpiringDeployment(t, srv, ctx, addr, "foo", 10)
+
+	params := k.GetParams(ctx)
+	params.RenewalFeePerBlock = hugeStake()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := srv.RenewDeployment(sdk.WrapSDKContext(ctx), &types.MsgRenewDeploymentRequest{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 20})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "renewal fee overflow")
+	meta, found := k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, int64(10), meta.ExpiresAtHeight)
+}
+
+func TestPruneExpiredDeployments(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.ExpiredPruneLimit = 1
+	params.DepositPerByte = stake(1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+	createExpiringDeployment(t, srv, ctx, addr, "foo", 5)
+	createExpiringDeployment(t, srv, ctx, addr, "bar", 5)
+	createExpiringDeployment(t, srv, ctx, addr, "baz", 10)
+	createExpiringDeployment(t, srv, ctx, addr, "qux", 0)
+	require.Equal(t, stake(60), bank.Balance(addr))
+
+	// Nothing expired yet
+	require.NoError(t, k.PruneExpiredDeployments(ctx.WithBlockHeight(4)))
+	require.Len(t, k.GetAllMetaByCreator(ctx, addr), 4)
+
+	// One expired deployment is pruned per block
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.Len(t, k.GetAllMetaByCreator(ctx, addr), 3)
+	require.False(t, k.HasDeployment(ctx, addr, "bar"))
+	requireTypedEvent(t, ctx, &types.EventDeploymentExpired{Creator: addr.String(), Name: "bar", ExpiresAtHeight: 5})
+	require.Equal(t, stake(70), bank.Balance(addr))
+
+	ctx = ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.False(t, k.HasDeployment(ctx, addr, "foo"))
+	requireTypedEvent(t, ctx, &types.EventDeploymentExpired{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 5})
+
+	require.NoError(t, k.PruneExpiredDeployments(ctx.WithBlockHeight(7)))
+	require.True(t, k.HasDeployment(ctx, addr, "baz"))
+
+	require.NoError(t, k.PruneExpiredDeployments(ctx.WithBlockHeight(10)))
+	require.False(t, k.HasDeployment(ctx, addr, "baz"))
+	require.True(t, k.HasDeployment(ctx, addr, "qux"))
+	require.Equal(t, stake(90), bank.Balance(addr))
+	require.Equal(t, stake(10), bank.ModuleBalance(types.ModuleName))
+}
+
+func TestPruneExpiredDeploymentsRefundFailure(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.DepositPerByte = stake(1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	bank.Fund(addr, stake(100))
+	createExpiringDeployment(t, srv, ctx, addr, "foo", 5)
+
+	// Drain the module account so that the refund fails
+	other := sdk.AccAddress("other")
+	require.NoError(t, bank.SendCoinsFromModuleToAccount(ctx, types.ModuleName, other, stake(10)))
+
+	ctx = ctx.WithBlockHeight(5).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.True(t, k.HasDeployment(ctx, addr, "foo"))
+	requireTypedEvent(t, ctx, &types.EventDeploymentExpiryFailed{
+		Creator:         addr.String(),
+		Name:            "foo",
+		ExpiresAtHeight: 5,
+		Error:           "unable to refund the deployment deposit:  is smaller than 10stake: insufficient funds",
+	})
+
+	// The removal is retried once the refund succeeds
+	bank.Fund(authtypes.NewModuleAddress(types.ModuleName), stake(10))
+	ctx = ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.False(t, k.HasDeployment(ctx, addr, "foo"))
+	requireTypedEvent(t, ctx, &types.EventDeploymentExpired{Creator: addr.String(), Name: "foo", ExpiresAtHeight: 5})
+	require.Equal(t, stake(100), bank.Balance(addr))
+}
+
+func TestPruneExpiredDeploymentsDanglingEntries(t *testing.T) {
+	k, ctx, storeKey := keepertest.GhostcloudKeeperWithStoreKey(t)
+	srv := keeper.NewMsgServerImpl(*k)
+
+	params := k.GetParams(ctx)
+	params.ExpiredPruneLimit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.AccAddress("creator")
+	createExpiringDeployment(t, srv, ctx, addr, "foo", 5)
+
+	store := prefix.NewStore(ctx.KVStore(storeKey), types.DeploymentExpiryKeyPrefix)
+	unparsable := append(sdk.Uint64ToBigEndian(1), 0xff)
+	missing := types.ExpiryKey(2, addr, "bar")
+	stale := types.ExpiryKey(3, addr, "foo")
+	for _, key := range [][]byte{unparsable, missing, stale} {
+		store.Set(key, []byte{})
+	}
+
+	// The dangling entries are deleted and count against the limit
+	ctx = ctx.WithBlockHeight(5)
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.False(t, store.Has(unparsable))
+	require.False(t, store.Has(missing))
+	require.True(t, store.Has(stale))
+	require.True(t, k.HasDeployment(ctx, addr, "foo"))
+
+	require.NoError(t, k.PruneExpiredDeployments(ctx))
+	require.False(t, store.Has(stale))
+	require.False(t, k.HasDeployment(ctx, addr, "foo"))
+}
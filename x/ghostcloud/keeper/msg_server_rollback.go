@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func validateRollbackDeploymentRequest(msg *types.MsgRollbackDeploymentRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (k msgServer) RollbackDeployment(goCtx context.Context, msg *types.MsgRollbackDeploymentRequest) (*types.MsgRollbackDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateRollbackDeploymentRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	meta, found := k.GetMeta(ctx, addr, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to rollback a non-existing deployment")
+	}
+
+	revision, found := k.GetRevision(ctx, addr, msg.Name, msg.Revision)
+	if !found {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, types.RevisionNotFound, msg.Revision)
+	}
+
+	// The revision holds a reference to its contents, they outlive the removal of the current dataset
+	items := make([]*types.Item, 0, len(revision.Items))
+	for _, meta := range revision.Items {
+		content, _, err := k.GetBlob(ctx, meta.GetHash())
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &types.Item{Meta: meta, Content: &types.ItemContent{Content: content}})
+	}
+	before := k.getItemMetas(ctx, addr, msg.Name)
+	k.RemoveDataset(ctx, addr, msg.Name)
+	k.SetDataset(ctx, addr, msg.Name, &types.Dataset{Items: items})
+	number := k.RecordRevision(ctx, addr, msg.Name)
+	touchMeta(ctx, &meta)
+	if err := k.settleDeposit(ctx, addr, &meta); err != nil {
+		return nil, err
+	}
+
+	if err := k.emitDeploymentUpdated(ctx, addr, &meta, before); err != nil {
+		return nil, err
+	}
+	return &types.MsgRollbackDeploymentResponse{Revision: number}, nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func createIndexPayload(content string) *types.Payload {
+	return &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{
+		newItem("index.html", content),
+	}}}}
+}
+
+func TestDeploymentMsgServerRollback(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	meta := &types.Meta{Creator: addr.String(), Name: "foo"}
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v2")})
+	require.NoError(t, err)
+	// Updating the meta only does not record a revision
+	_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{Meta: meta})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetLatestRevisionNumber(ctx, addr, meta.Name))
+
+	first, found := k.GetRevision(ctx, addr, meta.Name, 1)
+	require.True(t, found)
+	second, found := k.GetRevision(ctx, addr, meta.Name, 2)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight(), second.Height)
+	require.NotEqual(t, first.Hash, second.Hash)
+
+	// The contents of the first revision are retained
+	_, found, err = k.GetBlob(ctx, types.ContentHash([]byte("v1")))
+	require.NoError(t, err)
+	require.True(t, found)
+
+	resp, err := srv.RollbackDeployment(sdk.WrapSDKContext(ctx), &types.MsgRollbackDeploymentRequest{
+		Creator:  meta.Creator,
+		Name:     meta.Name,
+		Revision: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), resp.Revision)
+
+	content, found, err := k.GetItemContent(ctx, addr, meta.Name, "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "v1", string(content.Content))
+
+	third, found := k.GetRevision(ctx, addr, meta.Name, 3)
+	require.True(t, found)
+	require.Equal(t, first.Hash, third.Hash)
+
+	requireTypedEvent(t, ctx, &types.EventDeploymentUpdated{
+		Creator:      meta.Creator,
+		Name:         meta.Name,
+		Revision:     3,
+		DatasetHash:  first.Hash,
+		ChangedPaths: []string{"index.html"},
+	})
+
+	// Removing the deployment releases the contents of all its revisions
+	_, err = srv.RemoveDeployment(sdk.WrapSDKContext(ctx), &types.MsgRemoveDeploymentRequest{Creator: meta.Creator, Name: meta.Name})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), k.GetLatestRevisionNumber(ctx, addr, meta.Name))
+	_, found, err = k.GetBlob(ctx, types.ContentHash([]byte("v1")))
+	require.NoError(t, err)
+	require.False(t, found)
+	_, found, err = k.GetBlob(ctx, types.ContentHash([]byte("v2")))
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestDeploymentMsgServerRollbackInvalid(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	meta := &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo"}
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+
+	_, err = srv.RollbackDeployment(sdk.WrapSDKContext(ctx), &types.MsgRollbackDeploymentRequest{Creator: meta.Creator, Name: meta.Name, Revision: 2})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, err = srv.RollbackDeployment(sdk.WrapSDKContext(ctx), &types.MsgRollbackDeploymentRequest{Creator: meta.Creator, Name: "bar", Revision: 1})
+	require.ErrorContains(t, err, "non-existing deployment")
+	_, err = srv.RollbackDeployment(sdk.WrapSDKContext(ctx), &types.MsgRollbackDeploymentRequest{Creator: meta.Creator, Revision: 1})
+	require.ErrorContains(t, err, types.NameShouldNotBeEmpty)
+}
+
+func TestRevisionPruning(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	meta := &types.Meta{Creator: addr.String(), Name: "foo"}
+	maxRevisions := k.GetParams(ctx).MaxRevisions
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+	for i := 2; i <= int(maxRevisions)+2; i++ {
+		_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{
+			Meta:    meta,
+			Payload: createIndexPayload(fmt.Sprintf("v%d", i)),
+		})
+		require.NoError(t, err)
+	}
+
+	latest := maxRevisions + 2
+	require.Equal(t, latest, k.GetLatestRevisionNumber(ctx, addr, meta.Name))
+	for number := uint64(1); number <= latest; number++ {
+		_, found := k.GetRevision(ctx, addr, meta.Name, number)
+		require.Equal(t, number > latest-maxRevisions, found, "revision %d", number)
+
+		_, found, err = k.GetBlob(ctx, types.ContentHash([]byte(fmt.Sprintf("v%d", number))))
+		require.NoError(t, err)
+		require.Equal(t, number > latest-maxRevisions, found, "content of revision %d", number)
+	}
+}
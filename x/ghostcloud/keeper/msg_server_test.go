@@ -8,6 +8,7 @@ import (
 	"ghostcloud/x/ghostcloud/keeper"
 	"ghostcloud/x/ghostcloud/types"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,6 +19,23 @@ func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
 
+// requireTypedEvent asserts that the last event of the given type emitted in the context equals the expected event.
+func requireTypedEvent(t *testing.T, ctx sdk.Context, expected proto.Message) {
+	t.Helper()
+
+	events := ctx.EventManager().ABCIEvents()
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Type != proto.MessageName(expected) {
+			continue
+		}
+		got, err := sdk.ParseTypedEvent(events[i])
+		require.NoError(t, err)
+		require.Equal(t, expected, got)
+		return
+	}
+	require.Failf(t, "event not found", "%s", proto.MessageName(expected))
+}
+
 func TestMsgServer(t *testing.T) {
 	ms, ctx := setupMsgServer(t)
 	require.NotNil(t, ms)
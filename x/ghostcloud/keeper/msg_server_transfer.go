@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func validateTransferDeploymentRequest(msg *types.MsgTransferDeploymentRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+// TransferDeployment offers a deployment to a new owner. The deployment is moved once the recipient accepts the
+// transfer. A new transfer replaces the pending one, and a transfer to the creator cancels it.
+func (k msgServer) TransferDeployment(goCtx context.Context, msg *types.MsgTransferDeploymentRequest) (*types.MsgTransferDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateTransferDeploymentRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, types.InvalidRecipientAddress, err)
+	}
+
+	if !k.HasDeployment(ctx, addr, msg.Name) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to transfer a non-existing deployment")
+	}
+
+	if recipient.Equals(addr) {
+		k.RemoveTransfer(ctx, addr, msg.Name)
+		return &types.MsgTransferDeploymentResponse{}, nil
+	}
+
+	k.SetTransfer(ctx, addr, &types.DeploymentTransfer{
+		Creator:   msg.Creator,
+		Name:      msg.Name,
+		Recipient: recipient.String(),
+	})
+
+	return &types.MsgTransferDeploymentResponse{}, nil
+}
+
+func validateAcceptDeploymentRequest(msg *types.MsgAcceptDeploymentRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	if err := validateName(msg.Name, params.MaxNameSize); err != nil {
+		return err
+	}
+	return nil
+}
+
+// AcceptDeployment completes the pending transfer of a deployment. The deposit of the deployment is refunded to the
+// previous owner and escrowed from the recipient.
+func (k msgServer) AcceptDeployment(goCtx context.Context, msg *types.MsgAcceptDeploymentRequest) (*types.MsgAcceptDeploymentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateAcceptDeploymentRequest(msg, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, types.InvalidRecipientAddress, err)
+	}
+
+	transfer, found := k.GetTransfer(ctx, addr, msg.Name)
+	if !found || transfer.GetRecipient() != recipient.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, types.TransferNotFound, msg.Name)
+	}
+
+	meta, found := k.GetMeta(ctx, addr, msg.Name)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to accept a non-existing deployment")
+	}
+	if k.HasDeployment(ctx, recipient, msg.Name) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "recipient already has a deployment with the same name")
+	}
+
+	if err := k.refundDeposit(ctx, addr, &meta); err != nil {
+		return nil, err
+	}
+	meta.Deposit = nil
+	k.moveDeployment(ctx, addr, recipient, &meta)
+	if err := k.settleDeposit(ctx, recipient, &meta); err != nil {
+		return nil, err
+	}
+
+	if err := emitDeploymentTransferred(ctx, &meta, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptDeploymentResponse{}, nil
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestDeploymentMsgServerTransfer(t *testing.T) {
+	k, ctx, bank := keepertest.GhostcloudKeeperWithBank(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	params := k.GetParams(ctx)
+	params.DepositPerByte = stake(2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	owner := sdk.AccAddress("owner")
+	recipient := sdk.AccAddress("recipient")
+	bank.Fund(owner, stake(100))
+	bank.Fund(recipient, stake(100))
+
+	meta := &types.Meta{Creator: owner.String(), Name: "foo", Domain: "example.com"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+	_, err = srv.UpdateDeployment(wctx, &types.MsgUpdateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v2")})
+	require.NoError(t, err)
+	created, found := k.GetMeta(ctx, owner, meta.Name)
+	require.True(t, found)
+	require.NotZero(t, created.Id)
+
+	k.SetCollaborator(ctx, owner, &types.Collaborator{Creator: owner.String(), Name: meta.Name, Address: sdk.AccAddress("editor").String(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR})
+
+	_, err = srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: meta.Name, Recipient: recipient.String()})
+	require.NoError(t, err)
+	transfer, found := k.GetTransfer(ctx, owner, meta.Name)
+	require.True(t, found)
+	require.Equal(t, recipient.String(), transfer.Recipient)
+
+	// Only the recipient can accept the transfer
+	_, err = srv.AcceptDeployment(wctx, &types.MsgAcceptDeploymentRequest{Recipient: sdk.AccAddress("other").String(), Creator: owner.String(), Name: meta.Name})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	_, err = srv.AcceptDeployment(wctx, &types.MsgAcceptDeploymentRequest{Recipient: recipient.String(), Creator: owner.String(), Name: meta.Name})
+	require.NoError(t, err)
+	requireTypedEvent(t, ctx, &types.EventDeploymentTransferred{
+		Id:            created.Id,
+		Name:          meta.Name,
+		PreviousOwner: owner.String(),
+		NewOwner:      recipient.String(),
+	})
+
+	require.False(t, k.HasDeployment(ctx, owner, meta.Name))
+	_, found = k.GetTransfer(ctx, owner, meta.Name)
+	require.False(t, found)
+
+	moved, found := k.GetMeta(ctx, recipient, meta.Name)
+	require.True(t, found)
+	require.Equal(t, created.Id, moved.Id)
+	require.Equal(t, recipient.String(), moved.Creator)
+	// The collaborators of the previous owner are dropped
+	require.Empty(t, k.GetAllCollaborators(ctx))
+
+	// The files, revisions, domain and identifier follow the deployment
+	content, found, err := k.GetItemContent(ctx, recipient, meta.Name, "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "v2", string(content.Content))
+	require.Equal(t, uint64(2), k.GetLatestRevisionNumber(ctx, recipient, meta.Name))
+	require.Zero(t, k.GetLatestRevisionNumber(ctx, owner, meta.Name))
+	byDomain, found := k.GetMetaByDomain(ctx, meta.Domain)
+	require.True(t, found)
+	require.Equal(t, recipient.String(), byDomain.Creator)
+	byID, found := k.GetMetaByID(ctx, created.Id)
+	require.True(t, found)
+	require.Equal(t, recipient.String(), byID.Creator)
+
+	// The deposit is refunded to the previous owner and escrowed from the recipient, the revisions retaining both
+	// contents
+	require.Equal(t, stake(100), bank.Balance(owner))
+	require.Equal(t, stake(92), bank.Balance(recipient))
+	requireDeposit(t, k, ctx, recipient, stake(8))
+
+	// Removing the deployment drops its identifier
+	_, err = srv.RemoveDeployment(wctx, &types.MsgRemoveDeploymentRequest{Creator: recipient.String(), Name: meta.Name})
+	require.NoError(t, err)
+	_, found = k.GetMetaByID(ctx, created.Id)
+	require.False(t, found)
+}
+
+func TestDeploymentMsgServerTransferCancel(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress("owner")
+	recipient := sdk.AccAddress("recipient")
+	meta := &types.Meta{Creator: owner.String(), Name: "foo"}
+	_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+	require.NoError(t, err)
+
+	_, err = srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: meta.Name, Recipient: recipient.String()})
+	require.NoError(t, err)
+
+	// A transfer to the creator cancels the pending transfer
+	_, err = srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: meta.Name, Recipient: owner.String()})
+	require.NoError(t, err)
+	_, found := k.GetTransfer(ctx, owner, meta.Name)
+	require.False(t, found)
+
+	_, err = srv.AcceptDeployment(wctx, &types.MsgAcceptDeploymentRequest{Recipient: recipient.String(), Creator: owner.String(), Name: meta.Name})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	require.True(t, k.HasDeployment(ctx, owner, meta.Name))
+}
+
+func TestDeploymentMsgServerTransferErrors(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress("owner")
+	recipient := sdk.AccAddress("recipient")
+	for _, creator := range []sdk.AccAddress{owner, recipient} {
+		meta := &types.Meta{Creator: creator.String(), Name: "foo"}
+		_, err := srv.CreateDeployment(wctx, &types.MsgCreateDeploymentRequest{Meta: meta, Payload: createIndexPayload("v1")})
+		require.NoError(t, err)
+	}
+
+	_, err := srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: "bar", Recipient: recipient.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: "foo", Recipient: "invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	// The recipient already has a deployment with the same name
+	_, err = srv.TransferDeployment(wctx, &types.MsgTransferDeploymentRequest{Creator: owner.String(), Name: "foo", Recipient: recipient.String()})
+	require.NoError(t, err)
+	_, err = srv.AcceptDeployment(wctx, &types.MsgAcceptDeploymentRequest{Recipient: recipient.String(), Creator: owner.String(), Name: "foo"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.True(t, k.HasDeployment(ctx, owner, "foo"))
+}
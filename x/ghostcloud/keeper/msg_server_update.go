@@ -22,43 +22,81 @@ func validateUpdateDeploymentRequest(msg *types.MsgUpdateDeploymentRequest, para
 	return nil
 }
 
+// UpdateDeployment updates an existing deployment. The signer must be the creator, or an editor or an admin of the
+// deployment. Editors may only replace the files: changing the domain or the description requires an admin.
 func (k msgServer) UpdateDeployment(goCtx context.Context, msg *types.MsgUpdateDeploymentRequest) (*types.MsgUpdateDeploymentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	params := k.GetParams(ctx)
 
 	err := validateUpdateDeploymentRequest(msg, params)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		return nil, wrapInvalidRequest(err)
 	}
 
 	addr, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
 	if err != nil {
 		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
 	}
+	if err := k.authorize(ctx, addr, msg.Meta.Name, msg.SignerAddress(), types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR); err != nil {
+		return nil, err
+	}
+	meta, found := k.GetMeta(ctx, addr, msg.Meta.Name)
+	if found && (meta.Domain != msg.Meta.Domain || meta.Description != msg.Meta.Description) {
+		if err := k.authorize(ctx, addr, msg.Meta.Name, msg.SignerAddress(), types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN); err != nil {
+			return nil, err
+		}
+	}
 
-	meta, found := k.GetMeta(ctx, addr, msg.GetMeta().GetName())
+	var dataset *types.Dataset
+	if msg.GetPayload() != nil {
+		dataset, err = HandlePayload(msg.Payload)
+		if err != nil {
+			return nil, wrapInvalidRequest(err)
+		}
+	}
+
+	if err := k.updateDeployment(ctx, addr, msg.Meta, dataset); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateDeploymentResponse{}, nil
+}
+
+// updateDeployment updates the description and domain of an existing deployment and, if the dataset is not nil,
+// replaces its files.
+func (k msgServer) updateDeployment(ctx sdk.Context, addr sdk.AccAddress, newMeta *types.Meta, dataset *types.Dataset) error {
+	meta, found := k.GetMeta(ctx, addr, newMeta.GetName())
 	if !found {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to update a non-existing deployment")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to update a non-existing deployment")
 	}
 
 	// The following should never happen since the store key uses the creator address
-	if meta.GetCreator() != msg.Meta.GetCreator() {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized")
+	if meta.GetCreator() != newMeta.GetCreator() {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized")
 	}
 
-	meta.Description = msg.Meta.Description
-	meta.Domain = msg.Meta.Domain
+	if err := k.checkDomainAvailable(ctx, addr, meta.Name, newMeta.Domain); err != nil {
+		return err
+	}
+
+	before := k.getItemMetas(ctx, addr, meta.Name)
+
+	meta.Description = newMeta.Description
+	if types.NormalizeDomain(newMeta.Domain) != types.NormalizeDomain(meta.Domain) {
+		meta.DomainVerified = false
+	}
+	meta.Domain = newMeta.Domain
+	touchMeta(ctx, &meta)
 
 	k.SetMeta(ctx, addr, &meta)
 
-	if msg.GetPayload() != nil {
-		dataset, err := HandlePayload(msg.Payload)
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	if dataset != nil {
+		k.RemoveDataset(ctx, addr, meta.Name)
+		k.SetDataset(ctx, addr, meta.Name, dataset)
+		k.RecordRevision(ctx, addr, meta.Name)
+		if err := k.settleDeposit(ctx, addr, &meta); err != nil {
+			return err
 		}
-		k.RemoveDataset(ctx, addr, msg.Meta.Name)
-		k.SetDataset(ctx, addr, msg.Meta.Name, dataset)
 	}
 
-	return &types.MsgUpdateDeploymentResponse{}, nil
+	return k.emitDeploymentUpdated(ctx, addr, &meta, before)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	keepertest "ghostcloud/testutil/keeper"
 	"ghostcloud/testutil/sample"
@@ -28,20 +29,28 @@ func testDeploymentMsgServerUpdate(t *testing.T, k *keeper.Keeper, ctx sdk.Conte
 				require.NoError(t, err)
 				creator, err := sdk.AccAddressFromBech32(meta.GetCreator())
 				require.NoError(t, err)
-				storeMeta, found := k.GetMeta(ctx, creator, meta.GetName())
-				require.True(t, found)
-				require.Equal(t, meta, &storeMeta)
-
-				storeDataset := k.GetDataset(ctx, creator, meta.GetName())
-				require.True(t, found)
+				storeDataset, err := k.GetDataset(ctx, creator, meta.GetName())
+				require.NoError(t, err)
 				switch payload.GetPayloadOption().(type) {
 				case *types.Payload_Archive:
 					dataset, err := keeper.HandlePayload(payload)
 					require.NoError(t, err)
+					sample.SetContentMetas(dataset)
 					require.Equal(t, dataset, storeDataset)
 				case *types.Payload_Dataset:
 					require.Equal(t, payload.GetDataset(), storeDataset)
 				}
+
+				// The total size and version are set by the module
+				expected := *meta
+				expected.Version = uint64(i + 1)
+				expected.TotalSize = 0
+				for _, item := range storeDataset.GetItems() {
+					expected.TotalSize += item.GetMeta().GetSize_()
+				}
+				storeMeta, found := k.GetMeta(ctx, creator, meta.GetName())
+				require.True(t, found)
+				require.Equal(t, &expected, &storeMeta)
 			} else {
 				require.Error(t, err)
 				require.ErrorContains(t, err, tc.Err.Error())
@@ -355,3 +364,98 @@ func TestDeploymentMsgServerUpdate(t *testing.T) {
 	testDeploymentMsgServerUpdateRemoveDomain(t, k, ctx)
 	testDeploymentMsgServerUpdateRemoveDescription(t, k, ctx)
 }
+
+func TestDeploymentMsgServerUpdateEvent(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	meta := &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo"}
+
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta: meta,
+		Payload: &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{
+			newItem("index.html", "index"),
+			newItem("style.css", "body {}"),
+		}}}},
+	})
+	require.NoError(t, err)
+
+	newMeta := &types.Meta{Creator: meta.Creator, Name: meta.Name, Domain: "foo.com"}
+	_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{
+		Meta: newMeta,
+		Payload: &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{
+			newItem("index.html", "index"),
+			newItem("app.js", "console.log()"),
+		}}}},
+	})
+	require.NoError(t, err)
+
+	datasetHash := types.DatasetHash([]*types.ItemMeta{
+		{Path: "app.js", Hash: types.ContentHash([]byte("console.log()"))},
+		{Path: "index.html", Hash: types.ContentHash([]byte("index"))},
+	})
+	requireTypedEvent(t, ctx, &types.EventDeploymentUpdated{
+		Creator:      meta.Creator,
+		Name:         meta.Name,
+		Domain:       newMeta.Domain,
+		Revision:     2,
+		DatasetHash:  datasetHash,
+		ChangedPaths: []string{"app.js", "style.css"},
+	})
+
+	// Updating the meta only does not change any path
+	_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{
+		Meta: &types.Meta{Creator: meta.Creator, Name: meta.Name, Description: "new"},
+	})
+	require.NoError(t, err)
+
+	requireTypedEvent(t, ctx, &types.EventDeploymentUpdated{
+		Creator:      meta.Creator,
+		Name:         meta.Name,
+		Revision:     2,
+		DatasetHash:  datasetHash,
+		ChangedPaths: []string{},
+	})
+}
+
+func TestDeploymentMsgServerUpdateTimestamps(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	updatedAt := createdAt.Add(time.Hour)
+
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(createdAt)
+	_, err := srv.CreateDeployment(sdk.WrapSDKContext(ctx), &types.MsgCreateDeploymentRequest{
+		Meta:    &types.Meta{Creator: addr.String(), Name: "foo", Version: 42},
+		Payload: &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{newItem("index.html", "index")}}}},
+	})
+	require.NoError(t, err)
+
+	meta, found := k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, int64(10), meta.CreatedAtHeight)
+	require.Equal(t, createdAt, meta.CreatedAtTime)
+	require.Equal(t, int64(10), meta.UpdatedAtHeight)
+	require.Equal(t, createdAt, meta.UpdatedAtTime)
+	require.Equal(t, uint64(1), meta.Version)
+
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(updatedAt)
+	_, err = srv.UpdateDeployment(sdk.WrapSDKContext(ctx), &types.MsgUpdateDeploymentRequest{
+		Meta: &types.Meta{Creator: addr.String(), Name: "foo", Description: "new"},
+	})
+	require.NoError(t, err)
+	_, err = srv.PatchDeployment(sdk.WrapSDKContext(ctx), &types.MsgPatchDeploymentRequest{
+		Creator: addr.String(),
+		Name:    "foo",
+		Upsert:  &types.Dataset{Items: []*types.Item{newItem("app.js", "app")}},
+	})
+	require.NoError(t, err)
+
+	meta, found = k.GetMeta(ctx, addr, "foo")
+	require.True(t, found)
+	require.Equal(t, int64(10), meta.CreatedAtHeight)
+	require.Equal(t, createdAt, meta.CreatedAtTime)
+	require.Equal(t, int64(12), meta.UpdatedAtHeight)
+	require.Equal(t, updatedAt, meta.UpdatedAtTime)
+	require.Equal(t, uint64(3), meta.Version)
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// validateUploadChunkRequest validates an upload chunk request, and replaces its path by its canonical form.
+func validateUploadChunkRequest(msg *types.MsgUploadChunkRequest, params types.Params) error {
+	if err := validateCreator(msg.Creator); err != nil {
+		return err
+	}
+	path, err := types.CanonicalPath(msg.Path)
+	if err != nil {
+		return err
+	}
+	msg.Path = path
+	if int64(msg.Size()) > params.MaxPayloadSize {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.PayloadTooBig, msg.Size(), params.MaxPayloadSize)
+	}
+	return nil
+}
+
+// validateUploadItems verifies the item metas of an upload session, and replaces their paths by their canonical form.
+func validateUploadItems(items []*types.ItemMeta) error {
+	paths := make(map[string]struct{})
+	for _, meta := range items {
+		if meta == nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "item meta cannot be nil")
+		}
+		path, err := types.CanonicalPath(meta.Path)
+		if err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return errorsmod.Wrap(types.ErrDuplicatePath, path)
+		}
+		paths[path] = struct{}{}
+		meta.Path = path
+
+		if err := types.ValidateItemMeta(meta); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+	return nil
+}
+
+// getCreatorUploadSession returns the upload session with the given id if it is owned by the creator. Expired
+// sessions waiting to be pruned are not found.
+func (k msgServer) getCreatorUploadSession(ctx sdk.Context, creator string, id uint64) (types.UploadSession, error) {
+	session, found := k.GetUploadSession(ctx, id)
+	if !found || session.GetExpiresAtHeight() <= ctx.BlockHeight() {
+		return session, errorsmod.Wrapf(sdkerrors.ErrNotFound, types.UploadSessionNotFound, id)
+	}
+	if session.GetMeta().GetCreator() != creator {
+		return session, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized")
+	}
+	return session, nil
+}
+
+func (k msgServer) BeginUpload(goCtx context.Context, msg *types.MsgBeginUploadRequest) (*types.MsgBeginUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateMeta(msg.Meta, params); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := validateUploadItems(msg.Items); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	// Fail early, the checks are repeated when the upload is committed
+	exists := k.HasDeployment(ctx, addr, msg.Meta.Name)
+	if msg.Update && !exists {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "unable to update a non-existing deployment")
+	}
+	if !msg.Update && exists {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+	}
+	if err := k.checkDomainAvailable(ctx, addr, msg.Meta.Name, msg.Meta.Domain); err != nil {
+		return nil, err
+	}
+	if count := k.CountUploadSessions(ctx, addr, params.MaxUploadSessionsPerCreator); count >= params.MaxUploadSessionsPerCreator {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.TooManyUploadSessions, count)
+	}
+
+	session := &types.UploadSession{
+		Id:              k.NextUploadSessionID(ctx),
+		Meta:            msg.Meta,
+		Update:          msg.Update,
+		ExpiresAtHeight: ctx.BlockHeight() + params.UploadSessionTtl,
+		Items:           msg.Items,
+	}
+	k.SetUploadSession(ctx, session)
+
+	return &types.MsgBeginUploadResponse{SessionId: session.Id}, nil
+}
+
+func (k msgServer) UploadChunk(goCtx context.Context, msg *types.MsgUploadChunkRequest) (*types.MsgUploadChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := validateUploadChunkRequest(msg, params); err != nil {
+		return nil, wrapInvalidRequest(err)
+	}
+
+	session, err := k.getCreatorUploadSession(ctx, msg.Creator, msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	size := session.UploadedSize + uint64(len(msg.Data))
+	if size > params.MaxUncompressedSize {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.UncompressedSizeTooBig, size, params.MaxUncompressedSize)
+	}
+
+	k.AppendUploadChunk(ctx, session.Id, msg.Path, msg.Data)
+	session.UploadedSize = size
+	k.SetUploadSession(ctx, &session)
+
+	return &types.MsgUploadChunkResponse{}, nil
+}
+
+func (k msgServer) CommitUpload(goCtx context.Context, msg *types.MsgCommitUploadRequest) (*types.MsgCommitUploadResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateCreator(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	session, err := k.getCreatorUploadSession(ctx, msg.Creator, msg.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddr, err)
+	}
+
+	dataset := k.GetUploadDataset(ctx, &session)
+	if err := verifyDatasetContent(dataset); err != nil {
+		return nil, err
+	}
+	paths := make(map[string]struct{}, len(dataset.Items))
+	for _, item := range dataset.Items {
+		paths[item.Meta.Path] = struct{}{}
+	}
+	for _, meta := range session.Items {
+		if _, ok := paths[meta.Path]; !ok {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, types.UploadItemNotFound, meta.Path)
+		}
+	}
+
+	if session.Update {
+		err = k.updateDeployment(ctx, addr, session.Meta, dataset)
+	} else {
+		err = k.createDeployment(ctx, addr, session.Meta, dataset)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveUploadSession(ctx, session.Id)
+
+	return &types.MsgCommitUploadResponse{}, nil
+}
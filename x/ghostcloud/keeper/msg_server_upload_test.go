@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func uploadChunks(t *testing.T, srv types.MsgServer, ctx sdk.Context, creator string, id uint64, path string, chunks ...string) {
+	for _, chunk := range chunks {
+		_, err := srv.UploadChunk(sdk.WrapSDKContext(ctx), &types.MsgUploadChunkRequest{
+			Creator:   creator,
+			SessionId: id,
+			Path:      path,
+			Data:      []byte(chunk),
+		})
+		require.NoError(t, err)
+	}
+}
+
+func TestDeploymentMsgServerUploadCreate(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	meta := &types.Meta{Creator: addr.String(), Name: "foo", Domain: "foo.com"}
+
+	resp, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: meta})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), resp.SessionId)
+
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "index.html", "<h1>", "index", "</h1>")
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "empty.txt", "")
+	require.False(t, k.HasDeployment(ctx, addr, meta.Name))
+
+	session, found := k.GetUploadSession(ctx, resp.SessionId)
+	require.True(t, found)
+	require.Equal(t, uint64(len("<h1>index</h1>")), session.UploadedSize)
+
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: meta.Creator, SessionId: resp.SessionId})
+	require.NoError(t, err)
+
+	content, found, err := k.GetItemContent(ctx, addr, meta.Name, "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "<h1>index</h1>", string(content.Content))
+	content, found, err = k.GetItemContent(ctx, addr, meta.Name, "empty.txt")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Empty(t, content.Content)
+
+	got, found := k.GetMetaByDomain(ctx, meta.Domain)
+	require.True(t, found)
+	require.Equal(t, meta.Name, got.Name)
+
+	_, found = k.GetUploadSession(ctx, resp.SessionId)
+	require.False(t, found)
+	require.Empty(t, k.GetUploadDataset(ctx, &types.UploadSession{Id: resp.SessionId}).Items)
+}
+
+func TestDeploymentMsgServerUploadUpdate(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+
+	resp, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{
+		Meta:   &types.Meta{Creator: meta.Creator, Name: meta.Name, Description: "new"},
+		Update: true,
+	})
+	require.NoError(t, err)
+
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "index.html", "<h1>new</h1>")
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: meta.Creator, SessionId: resp.SessionId})
+	require.NoError(t, err)
+
+	got, found := k.GetMeta(ctx, addr, meta.Name)
+	require.True(t, found)
+	require.Equal(t, "new", got.Description)
+
+	sizes := k.GetItemSizes(ctx, addr, meta.Name)
+	require.Equal(t, map[string]uint64{"index.html": uint64(len("<h1>new</h1>"))}, sizes)
+}
+
+func TestDeploymentMsgServerUploadItemMetas(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	addr := sdk.AccAddress("creator")
+	meta := &types.Meta{Creator: addr.String(), Name: "foo"}
+
+	resp, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{
+		Meta: meta,
+		Items: []*types.ItemMeta{
+			{Path: "./index.html", CacheControl: "no-cache"},
+			{Path: "data", ContentType: "application/json", CacheControl: "max-age=3600", ContentEncoding: "gzip"},
+		},
+	})
+	require.NoError(t, err)
+
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "index.html", "<h1>", "index", "</h1>")
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "data", "\x1f\x8b", "\x08\x00")
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "style.css", "h1 {}")
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: meta.Creator, SessionId: resp.SessionId})
+	require.NoError(t, err)
+
+	itemMeta, found := k.GetItemMeta(ctx, addr, meta.Name, "index.html")
+	require.True(t, found)
+	require.Equal(t, "text/html; charset=utf-8", itemMeta.ContentType)
+	require.Equal(t, "no-cache", itemMeta.CacheControl)
+	require.Empty(t, itemMeta.ContentEncoding)
+
+	itemMeta, found = k.GetItemMeta(ctx, addr, meta.Name, "data")
+	require.True(t, found)
+	require.Equal(t, "application/json", itemMeta.ContentType)
+	require.Equal(t, "max-age=3600", itemMeta.CacheControl)
+	require.Equal(t, "gzip", itemMeta.ContentEncoding)
+	require.Equal(t, uint64(4), itemMeta.Size_)
+
+	// Files without an item meta get the detected content type
+	itemMeta, found = k.GetItemMeta(ctx, addr, meta.Name, "style.css")
+	require.True(t, found)
+	require.Equal(t, "text/css; charset=utf-8", itemMeta.ContentType)
+	require.Empty(t, itemMeta.CacheControl)
+}
+
+func TestDeploymentMsgServerUploadInvalidItemMetas(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	meta := &types.Meta{Creator: sdk.AccAddress("creator").String(), Name: "foo"}
+
+	tests := []struct {
+		name  string
+		items []*types.ItemMeta
+		err   string
+	}{
+		{name: "nil item meta", items: []*types.ItemMeta{nil}, err: "item meta cannot be nil"},
+		{name: "invalid path", items: []*types.ItemMeta{{Path: "../index.html"}}, err: "`..` segments"},
+		{name: "duplicate path", items: []*types.ItemMeta{{Path: "index.html"}, {Path: "./index.html"}}, err: "duplicate path"},
+		{name: "invalid content type", items: []*types.ItemMeta{{Path: "index.html", ContentType: "text/"}}, err: "invalid content type"},
+		{name: "invalid content encoding", items: []*types.ItemMeta{{Path: "index.html", ContentEncoding: "lzma"}}, err: "invalid content encoding"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: meta, Items: tt.items})
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	// Every item meta must match an uploaded file
+	resp, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{
+		Meta:  meta,
+		Items: []*types.ItemMeta{{Path: "app.js", CacheControl: "no-cache"}},
+	})
+	require.NoError(t, err)
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "index.html", "<h1>index</h1>")
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: meta.Creator, SessionId: resp.SessionId})
+	require.ErrorContains(t, err, "no content uploaded for item: app.js")
+}
+
+func TestDeploymentMsgServerUploadInvalid(t *testing.T) {
+	k, ctx, meta := setupPatchDeployment(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	other := sdk.AccAddress("other").String()
+	params := k.GetParams(ctx)
+
+	_, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: meta})
+	require.ErrorContains(t, err, "index already set")
+	_, err = srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{
+		Meta:   &types.Meta{Creator: meta.Creator, Name: "bar"},
+		Update: true,
+	})
+	require.ErrorContains(t, err, "non-existing deployment")
+
+	resp, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{
+		Meta: &types.Meta{Creator: meta.Creator, Name: "bar"},
+	})
+	require.NoError(t, err)
+
+	_, err = srv.UploadChunk(sdk.WrapSDKContext(ctx), &types.MsgUploadChunkRequest{
+		Creator: other, SessionId: resp.SessionId, Path: "index.html",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UploadChunk(sdk.WrapSDKContext(ctx), &types.MsgUploadChunkRequest{
+		Creator: meta.Creator, SessionId: resp.SessionId + 1, Path: "index.html",
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	_, err = srv.UploadChunk(sdk.WrapSDKContext(ctx), &types.MsgUploadChunkRequest{
+		Creator: meta.Creator, SessionId: resp.SessionId,
+	})
+	require.ErrorContains(t, err, types.PathShouldNotBeEmpty)
+	_, err = srv.UploadChunk(sdk.WrapSDKContext(ctx), &types.MsgUploadChunkRequest{
+		Creator: meta.Creator, SessionId: resp.SessionId, Path: "big.bin", Data: make([]byte, params.MaxPayloadSize),
+	})
+	require.ErrorContains(t, err, "payload is too big")
+
+	uploadChunks(t, srv, ctx, meta.Creator, resp.SessionId, "app.js", "console.log()")
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: other, SessionId: resp.SessionId})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: meta.Creator, SessionId: resp.SessionId})
+	require.ErrorContains(t, err, types.IndexHtmlNotFound)
+}
+
+func TestPruneExpiredUploadSessions(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	creator := sdk.AccAddress("creator").String()
+	ttl := k.GetParams(ctx).UploadSessionTtl
+
+	first, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}})
+	require.NoError(t, err)
+	uploadChunks(t, srv, ctx, creator, first.SessionId, "index.html", "<h1>foo</h1>")
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	second, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "bar"}})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + ttl - 2)
+	k.PruneExpiredUploadSessions(ctx)
+	_, found := k.GetUploadSession(ctx, first.SessionId)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.PruneExpiredUploadSessions(ctx)
+	_, found = k.GetUploadSession(ctx, first.SessionId)
+	require.False(t, found)
+	require.Empty(t, k.GetUploadDataset(ctx, &types.UploadSession{Id: first.SessionId}).Items)
+	_, found = k.GetUploadSession(ctx, second.SessionId)
+	require.True(t, found)
+
+	_, err = srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: creator, SessionId: first.SessionId})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+}
+
+func TestPruneExpiredUploadSessionsLimit(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	params := k.GetParams(ctx)
+	params.ExpiredUploadPruneLimit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// Sessions of several creators sharing the same expiry height
+	var ids []uint64
+	for i := 0; i < 5; i++ {
+		creator := sample.AccAddress()
+		res, err := srv.BeginUpload(sdk.WrapSDKContext(ctx), &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}})
+		require.NoError(t, err)
+		uploadChunks(t, srv, ctx, creator, res.SessionId, "index.html", "<h1>foo</h1>")
+		ids = append(ids, res.SessionId)
+	}
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + params.UploadSessionTtl)
+	countSessions := func() int {
+		count := 0
+		for _, id := range ids {
+			if _, found := k.GetUploadSession(ctx, id); found {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Expired sessions can no longer be used while waiting to be pruned
+	_, err := srv.CommitUpload(sdk.WrapSDKContext(ctx), &types.MsgCommitUploadRequest{Creator: sample.AccAddress(), SessionId: ids[4]})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	k.PruneExpiredUploadSessions(ctx)
+	require.Equal(t, 3, countSessions())
+
+	// The remaining sessions are pruned at the end of the following blocks
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.PruneExpiredUploadSessions(ctx)
+	require.Equal(t, 1, countSessions())
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	k.PruneExpiredUploadSessions(ctx)
+	require.Equal(t, 0, countSessions())
+}
+
+func TestDeploymentMsgServerUploadTooManySessions(t *testing.T) {
+	k, ctx := keepertest.GhostcloudKeeper(t)
+	srv := keeper.NewMsgServerImpl(*k)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	max := k.GetParams(ctx).MaxUploadSessionsPerCreator
+
+	var ids []uint64
+	for i := uint64(0); i < max; i++ {
+		res, err := srv.BeginUpload(wctx, &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}})
+		require.NoError(t, err)
+		ids = append(ids, res.SessionId)
+	}
+	require.Equal(t, max, k.CountUploadSessions(ctx, sdk.MustAccAddressFromBech32(creator), max+1))
+
+	_, err := srv.BeginUpload(wctx, &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.ErrorContains(t, err, "too many open upload sessions")
+
+	// Other creators are not affected
+	_, err = srv.BeginUpload(wctx, &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: sample.AccAddress(), Name: "foo"}})
+	require.NoError(t, err)
+
+	// Closing a session frees a slot
+	k.RemoveUploadSession(ctx, ids[0])
+	_, err = srv.BeginUpload(wctx, &types.MsgBeginUploadRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}})
+	require.NoError(t, err)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) Collaborators(goCtx context.Context, req *types.QueryCollaboratorsRequest) (*types.QueryCollaboratorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(req.GetCreator())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	if err := types.ValidateNameKey(req.GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !k.HasDeployment(ctx, creator, req.GetName()) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var collaborators []*types.Collaborator
+	store := k.getCollaboratorStore(ctx, creator, req.GetName())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var collaborator types.Collaborator
+		if err := k.cdc.Unmarshal(value, &collaborator); err != nil {
+			return err
+		}
+		collaborators = append(collaborators, &collaborator)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollaboratorsResponse{Collaborators: collaborators, Pagination: pageRes}, nil
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCollaboratorsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 1, testkeeper.DATASET_SIZE)
+
+	collaborator := &types.Collaborator{Creator: metas[0].Creator, Name: metas[0].Name, Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR}
+	keeper.SetCollaborator(ctx, sdk.MustAccAddressFromBech32(collaborator.Creator), collaborator)
+
+	response, err := keeper.Collaborators(wctx, &types.QueryCollaboratorsRequest{Creator: collaborator.Creator, Name: collaborator.Name})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Collaborator{collaborator}, response.GetCollaborators())
+
+	_, err = keeper.Collaborators(wctx, &types.QueryCollaboratorsRequest{Creator: sample.AccAddress(), Name: collaborator.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.Collaborators(wctx, &types.QueryCollaboratorsRequest{Creator: "invalid", Name: collaborator.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"ghostcloud/x/ghostcloud/types"
 
@@ -20,16 +19,32 @@ func (k Keeper) Content(goCtx context.Context, req *types.QueryContentRequest) (
 
 	creator, err := sdk.AccAddressFromBech32(req.GetCreator())
 	if err != nil {
-		return nil, fmt.Errorf("invalid creator address: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	if err := types.ValidateNameKey(req.GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	content, found := k.GetItemContent(ctx, creator, req.GetName(), req.GetPath())
+	meta, found := k.GetItemMeta(ctx, creator, req.GetName(), req.GetPath())
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	content, encoding, found := k.GetEncodedBlob(ctx, meta.GetHash())
 	if !found {
-		return nil, status.Error(codes.InvalidArgument, "not found")
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	if !req.GetEncoded() {
+		content, err = types.DecodeContent(content, encoding)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to decode content: %v", err)
+		}
+		encoding = types.Encoding_ENCODING_IDENTITY
 	}
 
 	response := &types.QueryContentResponse{
-		Content: content.GetContent(),
+		Content:  content,
+		Meta:     &meta,
+		Encoding: encoding,
 	}
 
 	return response, nil
@@ -1,9 +1,11 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
 	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
 	"ghostcloud/x/ghostcloud/types"
 
 	"github.com/stretchr/testify/require"
@@ -28,6 +30,62 @@ func TestContentQuery(t *testing.T) {
 			require.NoError(t, err)
 			require.NotNil(t, response)
 			require.Equal(t, item.GetContent().GetContent(), response.GetContent())
+			require.Equal(t, item.GetMeta().GetPath(), response.GetMeta().GetPath())
+			require.Equal(t, types.ContentHash(item.GetContent().GetContent()), response.GetMeta().GetHash())
 		}
 	}
 }
+
+func TestContentQueryEncoded(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	addr := sdk.MustAccAddressFromBech32(creator)
+
+	html := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+	keeper.SetItem(ctx, addr, "foo", &types.Item{
+		Meta:    &types.ItemMeta{Path: "index.html"},
+		Content: &types.ItemContent{Content: html},
+	})
+	keeper.SetItem(ctx, addr, "foo", &types.Item{
+		Meta:    &types.ItemMeta{Path: "logo.png"},
+		Content: &types.ItemContent{Content: html},
+	})
+
+	// The content is stored compressed once, whatever the item sharing it
+	encoded, encoding, found := keeper.GetEncodedBlob(ctx, types.ContentHash(html))
+	require.True(t, found)
+	require.Equal(t, types.Encoding_ENCODING_BROTLI, encoding)
+	require.Less(t, len(encoded), len(html))
+
+	for _, path := range []string{"index.html", "logo.png"} {
+		response, err := keeper.Content(wctx, &types.QueryContentRequest{Creator: creator, Name: "foo", Path: path})
+		require.NoError(t, err)
+		require.Equal(t, html, response.GetContent())
+		require.Equal(t, types.Encoding_ENCODING_IDENTITY, response.GetEncoding())
+		require.Equal(t, uint64(len(html)), response.GetMeta().GetSize_())
+
+		response, err = keeper.Content(wctx, &types.QueryContentRequest{Creator: creator, Name: "foo", Path: path, Encoded: true})
+		require.NoError(t, err)
+		require.Equal(t, encoded, response.GetContent())
+		require.Equal(t, types.Encoding_ENCODING_BROTLI, response.GetEncoding())
+	}
+
+	content, found, err := keeper.GetItemContent(ctx, addr, "foo", "index.html")
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, html, content.GetContent())
+
+	// The encoding is deleted along with the content
+	require.True(t, keeper.RemoveItem(ctx, addr, "foo", "index.html"))
+	require.True(t, keeper.RemoveItem(ctx, addr, "foo", "logo.png"))
+	_, _, found = keeper.GetEncodedBlob(ctx, types.ContentHash(html))
+	require.False(t, found)
+	keeper.SetItem(ctx, addr, "foo", &types.Item{
+		Meta:    &types.ItemMeta{Path: "logo.png"},
+		Content: &types.ItemContent{Content: html},
+	})
+	_, encoding, found = keeper.GetEncodedBlob(ctx, types.ContentHash(html))
+	require.True(t, found)
+	require.Equal(t, types.Encoding_ENCODING_IDENTITY, encoding)
+}
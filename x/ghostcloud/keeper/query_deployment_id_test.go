@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeploymentByIdQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 1, testkeeper.DATASET_SIZE)
+	metas[0].Id = keeper.NextDeploymentID(ctx)
+	keeper.SetMeta(ctx, sdk.MustAccAddressFromBech32(metas[0].Creator), metas[0])
+
+	response, err := keeper.DeploymentById(wctx, &types.QueryDeploymentByIdRequest{Id: metas[0].Id})
+	require.NoError(t, err)
+	require.Equal(t, metas[0], response.GetMeta())
+
+	_, err = keeper.DeploymentById(wctx, &types.QueryDeploymentByIdRequest{Id: metas[0].Id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.DeploymentById(wctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
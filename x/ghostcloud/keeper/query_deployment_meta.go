@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) Meta(goCtx context.Context, req *types.QueryMetaRequest) (*types.QueryMetaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(req.GetCreator())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	if err := types.ValidateNameKey(req.GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	meta, found := k.GetMeta(ctx, creator, req.GetName())
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryMetaResponse{Meta: &meta}, nil
+}
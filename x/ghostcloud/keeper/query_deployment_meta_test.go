@@ -0,0 +1,36 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeploymentMetaQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 2, testkeeper.DATASET_SIZE)
+
+	for _, meta := range metas {
+		response, err := keeper.Meta(wctx, &types.QueryMetaRequest{Creator: meta.Creator, Name: meta.Name})
+		require.NoError(t, err)
+		require.Equal(t, meta, response.GetMeta())
+	}
+
+	_, err := keeper.Meta(wctx, &types.QueryMetaRequest{Creator: sample.AccAddress(), Name: metas[0].Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.Meta(wctx, &types.QueryMetaRequest{Creator: "invalid", Name: metas[0].Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.Meta(wctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) DeploymentByDomain(goCtx context.Context, req *types.QueryDeploymentByDomainRequest) (*types.QueryDeploymentByDomainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.GetDomain() == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty domain")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	meta, found := k.GetMetaByDomain(ctx, req.GetDomain())
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryDeploymentByDomainResponse{Meta: &meta}, nil
+}
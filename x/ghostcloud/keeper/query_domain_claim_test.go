@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDomainClaimQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 1, testkeeper.DATASET_SIZE)
+
+	claim := &types.DomainClaim{Creator: metas[0].Creator, Name: metas[0].Name, Domain: "example.com", Token: "token"}
+	keeper.SetDomainClaim(ctx, sdk.MustAccAddressFromBech32(claim.Creator), claim)
+
+	response, err := keeper.DomainClaim(wctx, &types.QueryDomainClaimRequest{Creator: claim.Creator, Name: claim.Name})
+	require.NoError(t, err)
+	require.Equal(t, claim, response.GetClaim())
+
+	_, err = keeper.DomainClaim(wctx, &types.QueryDomainClaimRequest{Creator: sample.AccAddress(), Name: claim.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.DomainClaim(wctx, &types.QueryDomainClaimRequest{Creator: "invalid", Name: claim.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryNameTooLong(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	creator := sample.AccAddress()
+	// Names longer than MaxNameSizeLimit do not fit in the length-prefixed store keys
+	name := strings.Repeat("a", int(types.MaxNameSizeLimit)+1)
+
+	_, err := keeper.Content(wctx, &types.QueryContentRequest{Creator: creator, Name: name, Path: "index.html"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.Meta(wctx, &types.QueryMetaRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.Items(wctx, &types.QueryItemsRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.Revisions(wctx, &types.QueryRevisionsRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.Collaborators(wctx, &types.QueryCollaboratorsRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.DomainClaim(wctx, &types.QueryDomainClaimRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = keeper.Transfer(wctx, &types.QueryTransferRequest{Creator: creator, Name: name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestDeploymentByDomainQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, testkeeper.NUM_DEPLOYMENT, testkeeper.DATASET_SIZE)
+
+	for _, meta := range metas {
+		response, err := keeper.DeploymentByDomain(wctx, &types.QueryDeploymentByDomainRequest{Domain: meta.GetDomain()})
+		require.NoError(t, err)
+		require.Equal(t, meta, response.GetMeta())
+	}
+
+	_, err := keeper.DeploymentByDomain(wctx, &types.QueryDeploymentByDomainRequest{Domain: "unknown.com"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.DeploymentByDomain(wctx, &types.QueryDeploymentByDomainRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.DeploymentByDomain(wctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestItemsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	meta := &types.Meta{Creator: addr.String(), Name: "foo"}
+	keeper.SetDeployment(ctx, addr, meta, &types.Dataset{Items: []*types.Item{
+		newItem("index.html", "<h1>index</h1>"),
+		newItem("assets/app.js", "app"),
+		newItem("empty.txt", ""),
+	}})
+	// A deployment whose name shares a prefix must not leak into the listing
+	keeper.SetDeployment(ctx, addr, &types.Meta{Creator: addr.String(), Name: "foobar"}, &types.Dataset{Items: []*types.Item{
+		newItem("other.html", "other"),
+	}})
+
+	expected := []*types.ItemMeta{
+		{Path: "assets/app.js", Hash: types.ContentHash([]byte("app")), Size_: 3, ContentType: "text/javascript; charset=utf-8"},
+		{Path: "empty.txt", Hash: types.ContentHash([]byte{}), Size_: 0, ContentType: "text/plain; charset=utf-8"},
+		{Path: "index.html", Hash: types.ContentHash([]byte("<h1>index</h1>")), Size_: 14, ContentType: "text/html; charset=utf-8"},
+	}
+
+	response, err := keeper.Items(wctx, &types.QueryItemsRequest{Creator: meta.Creator, Name: meta.Name})
+	require.NoError(t, err)
+	require.Equal(t, expected, response.GetItems())
+
+	// Paginate
+	var items []*types.ItemMeta
+	var next []byte
+	for {
+		response, err := keeper.Items(wctx, &types.QueryItemsRequest{Creator: meta.Creator, Name: meta.Name, Pagination: &query.PageRequest{Key: next, Limit: 2}})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(response.GetItems()), 2)
+		items = append(items, response.GetItems()...)
+		next = response.GetPagination().GetNextKey()
+		if next == nil {
+			break
+		}
+	}
+	require.Equal(t, expected, items)
+
+	_, err = keeper.Items(wctx, &types.QueryItemsRequest{Creator: meta.Creator, Name: "bar"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.Items(wctx, &types.QueryItemsRequest{Creator: "invalid", Name: meta.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 	"strings"
 
 	"ghostcloud/x/ghostcloud/types"
@@ -9,12 +11,66 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func metaPassesAllFilters(item *types.Meta, filters []*types.Filter) bool {
+// maxFilterRegexLength is the maximum length of a regular expression filter value.
+const maxFilterRegexLength = 256
+
+// metaFilter is a validated filter, with its regular expression compiled, if any.
+type metaFilter struct {
+	*types.Filter
+	regex *regexp.Regexp
+}
+
+// compileFilters validates the filters and compiles their regular expressions.
+func compileFilters(filters []*types.Filter) ([]metaFilter, error) {
+	compiled := make([]metaFilter, 0, len(filters))
+	for _, filter := range filters {
+		if filter == nil {
+			return nil, fmt.Errorf("empty filter")
+		}
+		if _, ok := types.Filter_Field_name[int32(filter.Field)]; !ok {
+			return nil, fmt.Errorf("unsupported filter field: %d", filter.Field)
+		}
+
+		f := metaFilter{Filter: filter}
+		switch filter.Operator {
+		case types.Filter_EQUAL, types.Filter_NOT_EQUAL, types.Filter_CONTAINS, types.Filter_NOT_CONTAINS, types.Filter_PREFIX:
+		case types.Filter_REGEX:
+			if len(filter.Value) > maxFilterRegexLength {
+				return nil, fmt.Errorf("regular expression too long: %d > %d", len(filter.Value), maxFilterRegexLength)
+			}
+			regex, err := regexp.Compile(filter.Value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression: %v", err)
+			}
+			f.regex = regex
+		default:
+			return nil, fmt.Errorf("unsupported filter operator: %s", filter.Operator)
+		}
+		compiled = append(compiled, f)
+	}
+	return compiled, nil
+}
+
+// compileFilterGroups validates the filter groups and compiles their regular expressions.
+func compileFilterGroups(groups []*types.FilterGroup) ([][]metaFilter, error) {
+	compiled := make([][]metaFilter, 0, len(groups))
+	for _, group := range groups {
+		filters, err := compileFilters(group.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, filters)
+	}
+	return compiled, nil
+}
+
+func metaPassesAllFilters(item *types.Meta, filters []metaFilter) bool {
 	for _, filter := range filters {
 		if !metaPassesFilter(item, filter) {
 			return false
@@ -23,12 +79,31 @@ func metaPassesAllFilters(item *types.Meta, filters []*types.Filter) bool {
 	return true
 }
 
-func metaPassesFilter(item *types.Meta, filter *types.Filter) bool {
+// metaPassesAnyGroup returns true if the meta passes all the filters of at least one group, or if there is no group.
+func metaPassesAnyGroup(item *types.Meta, groups [][]metaFilter) bool {
+	if len(groups) == 0 {
+		return true
+	}
+	for _, group := range groups {
+		if metaPassesAllFilters(item, group) {
+			return true
+		}
+	}
+	return false
+}
+
+func metaPassesFilter(item *types.Meta, filter metaFilter) bool {
 	var itemValue string
 
 	switch filter.Field {
 	case types.Filter_CREATOR:
 		itemValue = item.Creator
+	case types.Filter_NAME:
+		itemValue = item.Name
+	case types.Filter_DOMAIN:
+		itemValue = item.Domain
+	case types.Filter_DESCRIPTION:
+		itemValue = item.Description
 	default:
 		return false
 	}
@@ -42,32 +117,99 @@ func metaPassesFilter(item *types.Meta, filter *types.Filter) bool {
 		return strings.Contains(itemValue, filter.Value)
 	case types.Filter_NOT_CONTAINS:
 		return !strings.Contains(itemValue, filter.Value)
+	case types.Filter_PREFIX:
+		return strings.HasPrefix(itemValue, filter.Value)
+	case types.Filter_REGEX:
+		return filter.regex.MatchString(itemValue)
 	default:
 		return false
 	}
 }
 
+// creatorEqualFilter returns the creator address of the first valid `creator == address` filter, if any.
+func creatorEqualFilter(filters []*types.Filter) sdk.AccAddress {
+	for _, filter := range filters {
+		if filter.Field != types.Filter_CREATOR || filter.Operator != types.Filter_EQUAL {
+			continue
+		}
+		if addr, err := sdk.AccAddressFromBech32(filter.Value); err == nil {
+			return addr
+		}
+	}
+	return nil
+}
+
+// indexPrefixFilter returns the prefix of the index entries of the deployments that may pass the first non-empty
+// `field == value` or `field prefix value` filter on the given field, if any. The index keys start with the name,
+// followed by a zero byte, or with the normalized domain.
+func indexPrefixFilter(filters []*types.Filter, field types.Filter_Field) ([]byte, bool) {
+	for _, filter := range filters {
+		if filter.Field != field || filter.Value == "" {
+			continue
+		}
+		switch {
+		case field == types.Filter_NAME && filter.Operator == types.Filter_EQUAL:
+			return append([]byte(filter.Value), 0x00), true
+		case field == types.Filter_NAME && filter.Operator == types.Filter_PREFIX:
+			return []byte(filter.Value), true
+		case field == types.Filter_DOMAIN && (filter.Operator == types.Filter_EQUAL || filter.Operator == types.Filter_PREFIX):
+			// Domains are indexed lower case, the filter is still applied to the domain of the meta
+			return types.DomainKey(filter.Value), true
+		}
+	}
+	return nil, false
+}
+
+// getFilteredMetaStore returns the store to paginate to list the metas in the given order, narrowed down with the
+// creator, name or domain index when a filter applying to all the groups allows it, and a function returning the meta
+// of each entry of the store. The filters are still applied to each returned meta.
+func (k Keeper) getFilteredMetaStore(ctx sdk.Context, filters []*types.Filter, sortBy types.SortBy) (prefix.Store, func(key []byte, value []byte) (types.Meta, bool)) {
+	store, metaOf := k.getSortedMetaStore(ctx, sortBy)
+	// The value of the name and domain index entries is the deployment key
+	fromDeploymentKey := func(_ []byte, value []byte) (types.Meta, bool) {
+		return k.getMetaByDeploymentKey(ctx, value)
+	}
+
+	// The indexes are only used if they list the metas in the requested order
+	if addr := creatorEqualFilter(filters); addr != nil && sortBy == types.SortBy_SORT_BY_UNSPECIFIED {
+		return k.getCreatorMetaStore(ctx, addr), metaOf
+	}
+	if key, ok := indexPrefixFilter(filters, types.Filter_NAME); ok && (sortBy == types.SortBy_SORT_BY_UNSPECIFIED || sortBy == types.SortBy_SORT_BY_NAME) {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), append(types.DeploymentNameKeyPrefix, key...)), fromDeploymentKey
+	}
+	if key, ok := indexPrefixFilter(filters, types.Filter_DOMAIN); ok && sortBy == types.SortBy_SORT_BY_UNSPECIFIED {
+		return prefix.NewStore(ctx.KVStore(k.storeKey), append(types.DeploymentDomainKeyPrefix, key...)), fromDeploymentKey
+	}
+	return store, metaOf
+}
+
 func (k Keeper) Metas(goCtx context.Context, req *types.QueryMetasRequest) (*types.QueryMetasResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	filters, err := compileFilters(req.Filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	groups, err := compileFilterGroups(req.FilterGroups)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, ok := types.SortBy_name[int32(req.SortBy)]; !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported sort order: %d", req.SortBy)
+	}
+
 	var metas []*types.Meta
-	store := k.getDeploymentMetaStore(ctx)
+	store, metaOf := k.getFilteredMetaStore(ctx, req.Filters, req.SortBy)
 	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var meta types.Meta
-		if err := k.cdc.Unmarshal(value, &meta); err != nil {
-			return false, err
+		meta, found := metaOf(key, value)
+		if !found {
+			return false, nil
 		}
-		if req.Filters != nil {
-			if metaPassesAllFilters(&meta, req.Filters) {
-				if accumulate {
-					metas = append(metas, &meta)
-				}
-				return true, nil
-			}
-		} else {
+		if metaPassesAllFilters(&meta, filters) && metaPassesAnyGroup(&meta, groups) {
 			if accumulate {
 				metas = append(metas, &meta)
 			}
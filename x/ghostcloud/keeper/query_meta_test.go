@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"context"
 	"ghostcloud/x/ghostcloud/keeper"
+	"strings"
 	"testing"
 
 	"ghostcloud/testutil/sample"
@@ -11,6 +12,8 @@ import (
 	"ghostcloud/x/ghostcloud/types"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -147,3 +150,313 @@ func TestPaginatedMetaQuery(t *testing.T) {
 	require.Equal(t, metas[2], response.Meta[0])
 	require.Equal(t, metas[3], response.Meta[1])
 }
+
+func TestCreatorIndexMetaQuery(t *testing.T) {
+	gcKeeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	addr := sample.AccAddress()
+	testkeeper.CreateAndSetNDeployments(ctx, gcKeeper, testkeeper.NUM_DEPLOYMENT, testkeeper.DATASET_SIZE)
+	metas, _ := testkeeper.CreateAndSetNDeploymentsWithAddr(ctx, gcKeeper, testkeeper.NUM_DEPLOYMENT, testkeeper.DATASET_SIZE, addr)
+	testkeeper.CreateAndSetNDeployments(ctx, gcKeeper, testkeeper.NUM_DEPLOYMENT, testkeeper.DATASET_SIZE)
+
+	filters := createFilters(types.Filter_CREATOR, types.Filter_EQUAL, addr)
+	var got []*types.Meta
+	var nextKey []byte
+	for {
+		response := queryMetas(t, gcKeeper, wctx, filters, nextKey, 3)
+		got = append(got, response.Meta...)
+		nextKey = response.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+	require.ElementsMatch(t, metas, got)
+}
+
+func TestFieldFilterMetaQuery(t *testing.T) {
+	gcKeeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	blog := &types.Meta{Creator: addr.String(), Name: "blog", Description: "My personal blog", Domain: "blog.example.com"}
+	docs := &types.Meta{Creator: addr.String(), Name: "docs", Description: "Project documentation", Domain: "docs.example.org"}
+	shop := &types.Meta{Creator: addr.String(), Name: "shop-v2", Description: "Online shop"}
+	for _, meta := range []*types.Meta{blog, docs, shop} {
+		gcKeeper.SetDeployment(ctx, addr, meta, &types.Dataset{Items: []*types.Item{newItem("index.html", meta.Name)}})
+	}
+
+	filter := func(field types.Filter_Field, operator types.Filter_Operator, value string) *types.Filter {
+		return &types.Filter{Field: field, Operator: operator, Value: value}
+	}
+	tests := []struct {
+		desc     string
+		filters  []*types.Filter
+		groups   []*types.FilterGroup
+		expected []*types.Meta
+	}{
+		{
+			desc:     "name prefix",
+			filters:  []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "sh")},
+			expected: []*types.Meta{shop},
+		},
+		{
+			desc:     "domain contains",
+			filters:  []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_CONTAINS, "example")},
+			expected: []*types.Meta{blog, docs},
+		},
+		{
+			desc:     "description regex",
+			filters:  []*types.Filter{filter(types.Filter_DESCRIPTION, types.Filter_REGEX, "(?i)^(my|online) ")},
+			expected: []*types.Meta{blog, shop},
+		},
+		{
+			desc: "and",
+			filters: []*types.Filter{
+				filter(types.Filter_DOMAIN, types.Filter_CONTAINS, "example"),
+				filter(types.Filter_NAME, types.Filter_NOT_EQUAL, "blog"),
+			},
+			expected: []*types.Meta{docs},
+		},
+		{
+			desc: "or",
+			groups: []*types.FilterGroup{
+				{Filters: []*types.Filter{filter(types.Filter_NAME, types.Filter_EQUAL, "blog")}},
+				{Filters: []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_EQUAL, "")}},
+			},
+			expected: []*types.Meta{blog, shop},
+		},
+		{
+			desc:    "and with or",
+			filters: []*types.Filter{filter(types.Filter_CREATOR, types.Filter_EQUAL, addr.String())},
+			groups: []*types.FilterGroup{
+				{Filters: []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "d")}},
+				{Filters: []*types.Filter{
+					filter(types.Filter_DESCRIPTION, types.Filter_CONTAINS, "blog"),
+					filter(types.Filter_DOMAIN, types.Filter_NOT_CONTAINS, "example"),
+				}},
+			},
+			expected: []*types.Meta{docs},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := gcKeeper.Metas(wctx, &types.QueryMetasRequest{Filters: tc.filters, FilterGroups: tc.groups})
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.expected, response.Meta)
+		})
+	}
+}
+
+func TestIndexFilterMetaQuery(t *testing.T) {
+	gcKeeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	blog := &types.Meta{Creator: addr.String(), Name: "blog", Domain: "blog.example.com"}
+	blogV2 := &types.Meta{Creator: addr.String(), Name: "blog-v2", Domain: "Blog.Example.org"}
+	otherBlog := &types.Meta{Creator: other.String(), Name: "blog"}
+	docs := &types.Meta{Creator: other.String(), Name: "docs", Domain: "docs.example.com"}
+	for _, meta := range []*types.Meta{blog, blogV2, otherBlog, docs} {
+		gcKeeper.SetDeployment(ctx, sdk.MustAccAddressFromBech32(meta.Creator), meta, &types.Dataset{Items: []*types.Item{newItem("index.html", meta.Name)}})
+	}
+
+	filter := func(field types.Filter_Field, operator types.Filter_Operator, value string) *types.Filter {
+		return &types.Filter{Field: field, Operator: operator, Value: value}
+	}
+	tests := []struct {
+		desc     string
+		filters  []*types.Filter
+		groups   []*types.FilterGroup
+		sortBy   types.SortBy
+		expected []*types.Meta
+	}{
+		{
+			desc:     "name equal",
+			filters:  []*types.Filter{filter(types.Filter_NAME, types.Filter_EQUAL, "blog")},
+			expected: []*types.Meta{blog, otherBlog},
+		},
+		{
+			desc:     "name prefix",
+			filters:  []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "blo")},
+			expected: []*types.Meta{blog, blogV2, otherBlog},
+		},
+		{
+			desc:     "name prefix sorted by name",
+			filters:  []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "blog-")},
+			sortBy:   types.SortBy_SORT_BY_NAME,
+			expected: []*types.Meta{blogV2},
+		},
+		{
+			desc:     "name prefix sorted by size",
+			filters:  []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "d")},
+			sortBy:   types.SortBy_SORT_BY_TOTAL_SIZE,
+			expected: []*types.Meta{docs},
+		},
+		{
+			desc:     "domain equal",
+			filters:  []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_EQUAL, "blog.example.com")},
+			expected: []*types.Meta{blog},
+		},
+		{
+			// Domains are indexed lower case but filtered as stored
+			desc:     "domain prefix",
+			filters:  []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_PREFIX, "Blog.")},
+			expected: []*types.Meta{blogV2},
+		},
+		{
+			desc:     "empty domain",
+			filters:  []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_EQUAL, "")},
+			expected: []*types.Meta{otherBlog},
+		},
+		{
+			desc: "name and domain",
+			filters: []*types.Filter{
+				filter(types.Filter_NAME, types.Filter_PREFIX, "blog"),
+				filter(types.Filter_DOMAIN, types.Filter_PREFIX, "blog.example"),
+			},
+			expected: []*types.Meta{blog},
+		},
+		{
+			desc:    "domain prefix with or",
+			filters: []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_PREFIX, "blog")},
+			groups: []*types.FilterGroup{
+				{Filters: []*types.Filter{filter(types.Filter_CREATOR, types.Filter_EQUAL, addr.String())}},
+				{Filters: []*types.Filter{filter(types.Filter_NAME, types.Filter_EQUAL, "docs")}},
+			},
+			expected: []*types.Meta{blog},
+		},
+		{
+			desc:    "name prefix with or",
+			filters: []*types.Filter{filter(types.Filter_NAME, types.Filter_PREFIX, "blog")},
+			groups: []*types.FilterGroup{
+				{Filters: []*types.Filter{filter(types.Filter_CREATOR, types.Filter_EQUAL, other.String())}},
+				{Filters: []*types.Filter{filter(types.Filter_DOMAIN, types.Filter_CONTAINS, ".org")}},
+			},
+			expected: []*types.Meta{blogV2, otherBlog},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			// Paginate one meta at a time to check the next keys of the index stores
+			var got []*types.Meta
+			var nextKey []byte
+			for {
+				response, err := gcKeeper.Metas(wctx, &types.QueryMetasRequest{
+					Filters:      tc.filters,
+					FilterGroups: tc.groups,
+					SortBy:       tc.sortBy,
+					Pagination:   &query.PageRequest{Key: nextKey, Limit: 1},
+				})
+				require.NoError(t, err)
+				got = append(got, response.Meta...)
+				nextKey = response.Pagination.NextKey
+				if nextKey == nil {
+					break
+				}
+			}
+			require.ElementsMatch(t, tc.expected, got)
+		})
+	}
+}
+
+func TestInvalidFilterMetaQuery(t *testing.T) {
+	gcKeeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	tests := []struct {
+		desc   string
+		filter *types.Filter
+	}{
+		{desc: "invalid regex", filter: &types.Filter{Field: types.Filter_NAME, Operator: types.Filter_REGEX, Value: "("}},
+		{desc: "regex too long", filter: &types.Filter{Field: types.Filter_NAME, Operator: types.Filter_REGEX, Value: strings.Repeat("a", 257)}},
+		{desc: "unsupported operator", filter: &types.Filter{Field: types.Filter_NAME, Operator: types.Filter_GREATER_THAN, Value: "a"}},
+		{desc: "unsupported field", filter: &types.Filter{Field: types.Filter_Field(42), Operator: types.Filter_EQUAL, Value: "a"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := gcKeeper.Metas(wctx, &types.QueryMetasRequest{Filters: []*types.Filter{tc.filter}})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			_, err = gcKeeper.Metas(wctx, &types.QueryMetasRequest{FilterGroups: []*types.FilterGroup{{Filters: []*types.Filter{tc.filter}}}})
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestSortedMetaQuery(t *testing.T) {
+	gcKeeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	alice := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	bob := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	docs := &types.Meta{Creator: alice.String(), Name: "docs", CreatedAtHeight: 3, UpdatedAtHeight: 9}
+	blog := &types.Meta{Creator: bob.String(), Name: "blog", CreatedAtHeight: 1, UpdatedAtHeight: 4}
+	shop := &types.Meta{Creator: alice.String(), Name: "shop", CreatedAtHeight: 2, UpdatedAtHeight: 2}
+	gcKeeper.SetDeployment(ctx, alice, docs, &types.Dataset{Items: []*types.Item{newItem("index.html", "docs")}})
+	gcKeeper.SetDeployment(ctx, bob, blog, &types.Dataset{Items: []*types.Item{newItem("index.html", "my blog")}})
+	gcKeeper.SetDeployment(ctx, alice, shop, &types.Dataset{Items: []*types.Item{newItem("index.html", "a"), newItem("app.js", "b")}})
+
+	tests := []struct {
+		sortBy   types.SortBy
+		expected []*types.Meta
+	}{
+		{sortBy: types.SortBy_SORT_BY_CREATED_HEIGHT, expected: []*types.Meta{blog, shop, docs}},
+		{sortBy: types.SortBy_SORT_BY_UPDATED_HEIGHT, expected: []*types.Meta{shop, blog, docs}},
+		{sortBy: types.SortBy_SORT_BY_NAME, expected: []*types.Meta{blog, docs, shop}},
+		{sortBy: types.SortBy_SORT_BY_TOTAL_SIZE, expected: []*types.Meta{shop, docs, blog}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.sortBy.String(), func(t *testing.T) {
+			for _, reverse := range []bool{false, true} {
+				var got []*types.Meta
+				var nextKey []byte
+				for {
+					response, err := gcKeeper.Metas(wctx, &types.QueryMetasRequest{
+						SortBy:     tc.sortBy,
+						Pagination: &query.PageRequest{Key: nextKey, Limit: 2, Reverse: reverse},
+					})
+					require.NoError(t, err)
+					got = append(got, response.Meta...)
+					nextKey = response.Pagination.NextKey
+					if nextKey == nil {
+						break
+					}
+				}
+
+				expected := tc.expected
+				if reverse {
+					expected = make([]*types.Meta, len(tc.expected))
+					for i, meta := range tc.expected {
+						expected[len(tc.expected)-1-i] = meta
+					}
+				}
+				require.Equal(t, expected, got)
+			}
+		})
+	}
+
+	// Filters apply to the sorted listing
+	response, err := gcKeeper.Metas(wctx, &types.QueryMetasRequest{
+		SortBy:  types.SortBy_SORT_BY_NAME,
+		Filters: []*types.Filter{{Field: types.Filter_CREATOR, Operator: types.Filter_EQUAL, Value: alice.String()}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Meta{docs, shop}, response.Meta)
+
+	// Updating a deployment moves it in the indexes
+	shop.UpdatedAtHeight = 10
+	gcKeeper.SetMeta(ctx, alice, shop)
+	response, err = gcKeeper.Metas(wctx, &types.QueryMetasRequest{SortBy: types.SortBy_SORT_BY_UPDATED_HEIGHT})
+	require.NoError(t, err)
+	require.Equal(t, []*types.Meta{blog, docs, shop}, response.Meta)
+
+	// Removing a deployment removes it from the indexes
+	gcKeeper.Remove(ctx, bob, blog.Name)
+	for _, sortBy := range []types.SortBy{types.SortBy_SORT_BY_UPDATED_HEIGHT, types.SortBy_SORT_BY_NAME, types.SortBy_SORT_BY_TOTAL_SIZE} {
+		response, err = gcKeeper.Metas(wctx, &types.QueryMetasRequest{SortBy: sortBy})
+		require.NoError(t, err)
+		require.Len(t, response.Meta, 2)
+	}
+
+	_, err = gcKeeper.Metas(wctx, &types.QueryMetasRequest{SortBy: types.SortBy(42)})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
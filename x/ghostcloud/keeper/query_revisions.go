@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) Revisions(goCtx context.Context, req *types.QueryRevisionsRequest) (*types.QueryRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	creator, err := sdk.AccAddressFromBech32(req.GetCreator())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid creator address: %v", err)
+	}
+	if err := types.ValidateNameKey(req.GetName()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if !k.HasDeployment(ctx, creator, req.GetName()) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	var revisions []*types.Revision
+	store := k.getRevisionStore(ctx, creator, req.GetName())
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var revision types.Revision
+		if err := k.cdc.Unmarshal(value, &revision); err != nil {
+			return err
+		}
+		revisions = append(revisions, &revision)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRevisionsResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
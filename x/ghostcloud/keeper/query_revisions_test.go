@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+)
+
+func TestRevisionsQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 1, testkeeper.DATASET_SIZE)
+	addr := sdk.MustAccAddressFromBech32(metas[0].Creator)
+	for i := 0; i < 3; i++ {
+		keeper.RecordRevision(ctx, addr, metas[0].Name)
+	}
+
+	response, err := keeper.Revisions(wctx, &types.QueryRevisionsRequest{Creator: metas[0].Creator, Name: metas[0].Name})
+	require.NoError(t, err)
+	require.Len(t, response.Revisions, 3)
+	for i, revision := range response.Revisions {
+		require.Equal(t, uint64(i+1), revision.Number)
+		require.Len(t, revision.Items, testkeeper.DATASET_SIZE)
+	}
+
+	response, err = keeper.Revisions(wctx, &types.QueryRevisionsRequest{
+		Creator:    metas[0].Creator,
+		Name:       metas[0].Name,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, response.Revisions, 1)
+	require.Equal(t, uint64(3), response.Revisions[0].Number)
+	require.Equal(t, uint64(3), response.Pagination.Total)
+
+	_, err = keeper.Revisions(wctx, &types.QueryRevisionsRequest{Creator: sample.AccAddress(), Name: metas[0].Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.Revisions(wctx, &types.QueryRevisionsRequest{Creator: "invalid", Name: metas[0].Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testkeeper "ghostcloud/testutil/keeper"
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GhostcloudKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	metas, _ := testkeeper.CreateAndSetNDeployments(ctx, keeper, 1, testkeeper.DATASET_SIZE)
+
+	transfer := &types.DeploymentTransfer{Creator: metas[0].Creator, Name: metas[0].Name, Recipient: sample.AccAddress()}
+	keeper.SetTransfer(ctx, sdk.MustAccAddressFromBech32(transfer.Creator), transfer)
+
+	response, err := keeper.Transfer(wctx, &types.QueryTransferRequest{Creator: transfer.Creator, Name: transfer.Name})
+	require.NoError(t, err)
+	require.Equal(t, transfer, response.GetTransfer())
+
+	_, err = keeper.Transfer(wctx, &types.QueryTransferRequest{Creator: sample.AccAddress(), Name: transfer.Name})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = keeper.Transfer(wctx, &types.QueryTransferRequest{Creator: "invalid", Name: transfer.Name})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
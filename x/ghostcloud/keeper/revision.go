@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordRevision records the current files of a deployment as a new revision and prunes the oldest revisions beyond
+// the maximum number of revisions. It returns the number of the new revision.
+func (k Keeper) RecordRevision(ctx sdk.Context, addr sdk.AccAddress, name string) uint64 {
+	items := k.getItemMetas(ctx, addr, name)
+	// Revisions keep their contents alive
+	for _, item := range items {
+		k.setBlobRefCount(ctx, item.GetHash(), k.getBlobRefCount(ctx, item.GetHash())+1)
+	}
+
+	revision := &types.Revision{
+		Number: k.GetLatestRevisionNumber(ctx, addr, name) + 1,
+		Height: ctx.BlockHeight(),
+		Hash:   types.DatasetHash(items),
+		Items:  items,
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	store.Set(types.RevisionKey(addr, name, revision.Number), k.cdc.MustMarshal(revision))
+
+	k.pruneRevisions(ctx, addr, name, revision.Number, k.GetParams(ctx).MaxRevisions)
+
+	return revision.Number
+}
+
+// ImportRevision stores a revision of a deployment, e.g., at genesis. The contents of its files must be stored
+// already, or be provided by hash.
+func (k Keeper) ImportRevision(ctx sdk.Context, addr sdk.AccAddress, name string, revision *types.Revision, contents map[string][]byte) error {
+	for _, item := range revision.GetItems() {
+		if refCount := k.getBlobRefCount(ctx, item.GetHash()); refCount > 0 {
+			k.setBlobRefCount(ctx, item.GetHash(), refCount+1)
+			continue
+		}
+		content, ok := contents[string(item.GetHash())]
+		if !ok {
+			return fmt.Errorf("unknown content for revision item: %s", item.GetPath())
+		}
+		k.retainBlob(ctx, item, content)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	store.Set(types.RevisionKey(addr, name, revision.GetNumber()), k.cdc.MustMarshal(revision))
+	return nil
+}
+
+// pruneRevisions removes the revisions older than the last maxRevisions ones.
+func (k Keeper) pruneRevisions(ctx sdk.Context, addr sdk.AccAddress, name string, latest uint64, maxRevisions uint64) {
+	if latest <= maxRevisions {
+		return
+	}
+
+	k.removeRevisions(ctx, addr, name, latest-maxRevisions+1)
+}
+
+// removeRevisions removes the revisions of a deployment numbered below the given number.
+func (k Keeper) removeRevisions(ctx sdk.Context, addr sdk.AccAddress, name string, below uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	iterator := store.Iterator(types.RevisionKey(addr, name, 0), types.RevisionKey(addr, name, below))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.Revision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+		for _, item := range revision.GetItems() {
+			k.releaseBlob(ctx, item.GetHash())
+		}
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RemoveRevisions removes all the revisions of a deployment.
+func (k Keeper) RemoveRevisions(ctx sdk.Context, addr sdk.AccAddress, name string) {
+	k.removeRevisions(ctx, addr, name, k.GetLatestRevisionNumber(ctx, addr, name)+1)
+}
+
+// GetRevision returns a revision of a deployment.
+func (k Keeper) GetRevision(ctx sdk.Context, addr sdk.AccAddress, name string, number uint64) (revision types.Revision, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	b := store.Get(types.RevisionKey(addr, name, number))
+	if b == nil {
+		return revision, false
+	}
+
+	k.cdc.MustUnmarshal(b, &revision)
+	return revision, true
+}
+
+// GetLatestRevisionNumber returns the number of the latest revision of a deployment, or 0 if there is none.
+func (k Keeper) GetLatestRevisionNumber(ctx sdk.Context, addr sdk.AccAddress, name string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	iterator := sdk.KVStoreReversePrefixIterator(store, types.DeploymentKey(addr, name))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+	key := iterator.Key()
+	return sdk.BigEndianToUint64(key[len(key)-8:])
+}
+
+func (k Keeper) getRevisionStore(ctx sdk.Context, addr sdk.AccAddress, name string) prefix.Store {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	return prefix.NewStore(store, types.DeploymentKey(addr, name))
+}
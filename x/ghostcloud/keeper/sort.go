@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setSortKeys indexes a deployment by last update height, name and total size.
+func (k Keeper) setSortKeys(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) {
+	deploymentKey := types.DeploymentKey(addr, meta.GetName())
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.DeploymentUpdateHeightKeyPrefix).Set(types.UpdateHeightKey(meta.GetUpdatedAtHeight(), addr, meta.GetName()), deploymentKey)
+	prefix.NewStore(store, types.DeploymentNameKeyPrefix).Set(types.NameKey(addr, meta.GetName()), deploymentKey)
+	prefix.NewStore(store, types.DeploymentTotalSizeKeyPrefix).Set(types.TotalSizeKey(meta.GetTotalSize(), addr, meta.GetName()), deploymentKey)
+}
+
+// removeSortKeys removes a deployment from the last update height, name and total size indexes.
+func (k Keeper) removeSortKeys(ctx sdk.Context, addr sdk.AccAddress, meta *types.Meta) {
+	store := ctx.KVStore(k.storeKey)
+	prefix.NewStore(store, types.DeploymentUpdateHeightKeyPrefix).Delete(types.UpdateHeightKey(meta.GetUpdatedAtHeight(), addr, meta.GetName()))
+	prefix.NewStore(store, types.DeploymentNameKeyPrefix).Delete(types.NameKey(addr, meta.GetName()))
+	prefix.NewStore(store, types.DeploymentTotalSizeKeyPrefix).Delete(types.TotalSizeKey(meta.GetTotalSize(), addr, meta.GetName()))
+}
+
+// getSortedMetaStore returns the store to paginate to list the metas in the given order, and a function returning
+// the meta of each entry of the store.
+func (k Keeper) getSortedMetaStore(ctx sdk.Context, sortBy types.SortBy) (prefix.Store, func(key []byte, value []byte) (types.Meta, bool)) {
+	// The value of the index entries is the deployment key
+	fromDeploymentKey := func(_ []byte, value []byte) (types.Meta, bool) {
+		return k.getMetaByDeploymentKey(ctx, value)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	switch sortBy {
+	case types.SortBy_SORT_BY_CREATED_HEIGHT:
+		// The creation height index has no value, the deployment key follows the 8 bytes of the height
+		return prefix.NewStore(store, types.DeploymentCreationHeightKeyPrefix), func(key []byte, _ []byte) (types.Meta, bool) {
+			return k.getMetaByDeploymentKey(ctx, key[8:])
+		}
+	case types.SortBy_SORT_BY_UPDATED_HEIGHT:
+		return prefix.NewStore(store, types.DeploymentUpdateHeightKeyPrefix), fromDeploymentKey
+	case types.SortBy_SORT_BY_NAME:
+		return prefix.NewStore(store, types.DeploymentNameKeyPrefix), fromDeploymentKey
+	case types.SortBy_SORT_BY_TOTAL_SIZE:
+		return prefix.NewStore(store, types.DeploymentTotalSizeKeyPrefix), fromDeploymentKey
+	default:
+		return k.getDeploymentMetaStore(ctx), func(_ []byte, value []byte) (meta types.Meta, found bool) {
+			if err := k.cdc.Unmarshal(value, &meta); err != nil {
+				return meta, false
+			}
+			return meta, true
+		}
+	}
+}
+
+// getMetaByDeploymentKey returns the meta of the deployment with the given deployment key.
+func (k Keeper) getMetaByDeploymentKey(ctx sdk.Context, deploymentKey []byte) (meta types.Meta, found bool) {
+	addr, name, _, err := types.ParseDeploymentKey(deploymentKey)
+	if err != nil {
+		return meta, false
+	}
+	return k.GetMeta(ctx, addr, name)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k Keeper) SetTransfer(ctx sdk.Context, addr sdk.AccAddress, transfer *types.DeploymentTransfer) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferKeyPrefix)
+	store.Set(types.DeploymentKey(addr, transfer.GetName()), k.cdc.MustMarshal(transfer))
+}
+
+func (k Keeper) GetTransfer(ctx sdk.Context, addr sdk.AccAddress, name string) (transfer types.DeploymentTransfer, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferKeyPrefix)
+	b := store.Get(types.DeploymentKey(addr, name))
+	if b == nil {
+		return transfer, false
+	}
+
+	k.cdc.MustUnmarshal(b, &transfer)
+	return transfer, true
+}
+
+func (k Keeper) RemoveTransfer(ctx sdk.Context, addr sdk.AccAddress, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferKeyPrefix)
+	store.Delete(types.DeploymentKey(addr, name))
+}
+
+func (k Keeper) GetAllTransfers(ctx sdk.Context) (transfers []*types.DeploymentTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TransferKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.DeploymentTransfer
+		k.cdc.MustUnmarshal(iterator.Value(), &transfer)
+
+		transfers = append(transfers, &transfer)
+	}
+
+	return transfers
+}
+
+// moveDeployment moves the meta, files and revisions of a deployment to a new owner. The deployment keeps its
+// identifier, domain and expiry. The contents are shared by both keys, so the blob reference counts are unchanged.
+// Pending domain claims, transfers and collaborators of the deployment are dropped.
+func (k Keeper) moveDeployment(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, meta *types.Meta) {
+	name := meta.GetName()
+
+	itemMetaStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentItemMetaPrefix)
+	for _, item := range k.getItemMetas(ctx, from, name) {
+		itemMetaStore.Delete(types.DeploymentItemKey(from, name, item.GetPath()))
+		itemMetaStore.Set(types.DeploymentItemKey(to, name, item.GetPath()), k.cdc.MustMarshal(item))
+	}
+
+	k.moveRevisions(ctx, from, to, name)
+
+	k.removeDomain(ctx, from, name, meta.GetDomain())
+	k.removeCreationHeight(ctx, from, name, meta.GetCreatedAtHeight())
+	k.removeSortKeys(ctx, from, meta)
+	k.removeExpiry(ctx, from, name, meta.GetExpiresAtHeight())
+	k.RemoveDomainClaim(ctx, from, name)
+	k.RemoveTransfer(ctx, from, name)
+	k.removeCollaborators(ctx, from, name)
+	metaStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DeploymentMetaKeyPrefix)
+	metaStore.Delete(types.DeploymentKey(from, name))
+
+	meta.Creator = to.String()
+	k.SetMeta(ctx, to, meta)
+}
+
+// moveRevisions moves the revisions of a deployment to a new owner, keeping their numbers.
+func (k Keeper) moveRevisions(ctx sdk.Context, from sdk.AccAddress, to sdk.AccAddress, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevisionKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.DeploymentKey(from, name))
+
+	var revisions []types.Revision
+	for ; iterator.Valid(); iterator.Next() {
+		var revision types.Revision
+		k.cdc.MustUnmarshal(iterator.Value(), &revision)
+		revisions = append(revisions, revision)
+	}
+	iterator.Close()
+
+	for i := range revisions {
+		store.Delete(types.RevisionKey(from, name, revisions[i].Number))
+		store.Set(types.RevisionKey(to, name, revisions[i].Number), k.cdc.MustMarshal(&revisions[i]))
+	}
+}
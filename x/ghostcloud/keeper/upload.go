@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextUploadSessionID returns a new upload session id.
+func (k Keeper) NextUploadSessionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	var id uint64
+	if b := store.Get(types.UploadSessionSeqKey); b != nil {
+		id = sdk.BigEndianToUint64(b)
+	}
+	id++
+	store.Set(types.UploadSessionSeqKey, sdk.Uint64ToBigEndian(id))
+
+	return id
+}
+
+// SetUploadSession stores an upload session and indexes it by expiry height and creator.
+func (k Keeper) SetUploadSession(ctx sdk.Context, session *types.UploadSession) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadSessionKeyPrefix)
+	if b := store.Get(types.UploadSessionKey(session.Id)); b != nil {
+		var previous types.UploadSession
+		k.cdc.MustUnmarshal(b, &previous)
+		k.removeUploadExpiry(ctx, previous.ExpiresAtHeight, previous.Id)
+	}
+
+	store.Set(types.UploadSessionKey(session.Id), k.cdc.MustMarshal(session))
+
+	expiryStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadExpiryKeyPrefix)
+	expiryStore.Set(types.UploadExpiryKey(session.ExpiresAtHeight, session.Id), []byte{})
+
+	if addr, err := sdk.AccAddressFromBech32(session.GetMeta().GetCreator()); err == nil {
+		creatorStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadCreatorKeyPrefix)
+		creatorStore.Set(types.UploadCreatorKey(addr, session.Id), []byte{})
+	}
+}
+
+// CountUploadSessions returns the number of upload sessions of a creator, counting up to max sessions.
+func (k Keeper) CountUploadSessions(ctx sdk.Context, addr sdk.AccAddress, max uint64) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadCreatorKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.CreatorKey(addr))
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid() && count < max; iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetUploadSession returns the upload session with the given id.
+func (k Keeper) GetUploadSession(ctx sdk.Context, id uint64) (session types.UploadSession, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadSessionKeyPrefix)
+	b := store.Get(types.UploadSessionKey(id))
+	if b == nil {
+		return session, false
+	}
+
+	k.cdc.MustUnmarshal(b, &session)
+	return session, true
+}
+
+// RemoveUploadSession deletes an upload session and its uploaded files.
+func (k Keeper) RemoveUploadSession(ctx sdk.Context, id uint64) {
+	session, found := k.GetUploadSession(ctx, id)
+	if !found {
+		return
+	}
+
+	chunkStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadChunkKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(chunkStore, types.UploadSessionKey(id))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range keys {
+		chunkStore.Delete(key)
+	}
+
+	k.removeUploadExpiry(ctx, session.ExpiresAtHeight, id)
+	if addr, err := sdk.AccAddressFromBech32(session.GetMeta().GetCreator()); err == nil {
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadCreatorKeyPrefix).Delete(types.UploadCreatorKey(addr, id))
+	}
+	prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadSessionKeyPrefix).Delete(types.UploadSessionKey(id))
+}
+
+func (k Keeper) removeUploadExpiry(ctx sdk.Context, height int64, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadExpiryKeyPrefix)
+	store.Delete(types.UploadExpiryKey(height, id))
+}
+
+// AppendUploadChunk appends data to a file of an upload session.
+func (k Keeper) AppendUploadChunk(ctx sdk.Context, id uint64, path string, data []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadChunkKeyPrefix)
+	key := types.UploadChunkKey(id, path)
+
+	// Empty files are stored as empty values, nil values are not allowed
+	content := append([]byte{}, store.Get(key)...)
+	store.Set(key, append(content, data...))
+}
+
+// GetUploadDataset returns the files uploaded to a session, sorted by path, with the item metas set when the session
+// was opened.
+func (k Keeper) GetUploadDataset(ctx sdk.Context, session *types.UploadSession) *types.Dataset {
+	metas := make(map[string]*types.ItemMeta, len(session.Items))
+	for _, meta := range session.Items {
+		metas[meta.Path] = meta
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadChunkKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.UploadSessionKey(session.Id))
+	defer iterator.Close()
+
+	items := make([]*types.Item, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		path := string(iterator.Key()[len(types.UploadSessionKey(session.Id)):])
+		meta := &types.ItemMeta{Path: path}
+		if sessionMeta, ok := metas[path]; ok {
+			meta.ContentType = sessionMeta.ContentType
+			meta.CacheControl = sessionMeta.CacheControl
+			meta.ContentEncoding = sessionMeta.ContentEncoding
+		}
+		items = append(items, &types.Item{
+			Meta:    meta,
+			Content: &types.ItemContent{Content: iterator.Value()},
+		})
+	}
+
+	return &types.Dataset{Items: items}
+}
+
+// PruneExpiredUploadSessions removes the upload sessions expiring at or before the current block height, oldest
+// expiry first, up to the expired upload prune limit param. The remaining ones are removed at the end of the following
+// blocks.
+func (k Keeper) PruneExpiredUploadSessions(ctx sdk.Context) {
+	limit := k.GetParams(ctx).ExpiredUploadPruneLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UploadExpiryKeyPrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// Delete the index entry as well, in case the session is already gone
+		store.Delete(key)
+		k.RemoveUploadSession(ctx, sdk.BigEndianToUint64(key[8:]))
+	}
+}
@@ -0,0 +1,26 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Legacy store prefixes of consensus version 1.
+var (
+	LegacyDeploymentMetaKeyPrefix     = []byte{0x00}
+	LegacyDeploymentItemMetaPrefix    = []byte{0x01, 0x00}
+	LegacyDeploymentItemContentPrefix = []byte{0x01, 0x01}
+)
+
+// LegacyDeploymentKey returns the store key of a deployment in consensus version 1, i.e., the raw creator address
+// followed by the raw deployment name.
+func LegacyDeploymentKey(addr sdk.AccAddress, name string) []byte {
+	var key []byte
+	key = append(key, addr...)
+	key = append(key, name...)
+	return key
+}
+
+// LegacyDeploymentItemKey returns the store key of a deployment item in consensus version 1.
+func LegacyDeploymentItemKey(addr sdk.AccAddress, name string, path string) []byte {
+	return append(LegacyDeploymentKey(addr, name), path...)
+}
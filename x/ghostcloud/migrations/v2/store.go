@@ -0,0 +1,290 @@
+package v2
+
+import (
+	"bytes"
+
+	"ghostcloud/x/ghostcloud/exported"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateStore migrates the x/ghostcloud module state from the consensus version 1 to version 2.
+// Deployments and items are moved from the raw concatenated keys to the length-prefixed keys, item contents are moved
+// to the content-addressed blob store and the deployments are indexed. The current files of each deployment are
+// recorded as its first revision, and the params are moved from the legacy x/params subspace to the module store.
+//
+// Legacy item keys are ambiguous, e.g., name "ab" with path "c" and name "a" with path "bc" share the same key. Items
+// are attributed to the deployment whose name, followed by the path stored in the item meta, matches the key.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	if err := migrateDeployments(store, cdc); err != nil {
+		return err
+	}
+	if err := indexDomains(store, cdc); err != nil {
+		return err
+	}
+	if err := indexDeployments(store, cdc); err != nil {
+		return err
+	}
+	if err := recordRevisions(ctx, store, cdc); err != nil {
+		return err
+	}
+	if err := migrateParams(ctx, store, legacySubspace, cdc); err != nil {
+		return err
+	}
+
+	for _, p := range [][]byte{
+		LegacyDeploymentMetaKeyPrefix,
+		LegacyDeploymentItemMetaPrefix,
+		LegacyDeploymentItemContentPrefix,
+	} {
+		deletePrefix(store, p)
+	}
+
+	return nil
+}
+
+// migrateDeployments moves the deployments and their items to the length-prefixed keys. Each deployment is assigned a
+// stable identifier, in legacy key order, and its version is set to its first revision. The hash, size and content
+// type of each item are stored in its meta, and the total size of the items in the deployment meta.
+func migrateDeployments(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	legacyMetaStore := prefix.NewStore(store, LegacyDeploymentMetaKeyPrefix)
+	legacyItemMetaStore := prefix.NewStore(store, LegacyDeploymentItemMetaPrefix)
+	legacyItemContentStore := prefix.NewStore(store, LegacyDeploymentItemContentPrefix)
+	metaStore := prefix.NewStore(store, types.DeploymentMetaKeyPrefix)
+	itemMetaStore := prefix.NewStore(store, types.DeploymentItemMetaPrefix)
+	idStore := prefix.NewStore(store, types.DeploymentIdKeyPrefix)
+
+	iterator := legacyMetaStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var seq uint64
+	for ; iterator.Valid(); iterator.Next() {
+		var meta types.Meta
+		if err := cdc.Unmarshal(iterator.Value(), &meta); err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(meta.GetCreator())
+		if err != nil {
+			return err
+		}
+
+		legacyKey := LegacyDeploymentKey(addr, meta.GetName())
+		itemIterator := sdk.KVStorePrefixIterator(legacyItemMetaStore, legacyKey)
+		for ; itemIterator.Valid(); itemIterator.Next() {
+			var itemMeta types.ItemMeta
+			if err := cdc.Unmarshal(itemIterator.Value(), &itemMeta); err != nil {
+				itemIterator.Close()
+				return err
+			}
+
+			// Skip the items of other deployments sharing the same key prefix
+			if !bytes.Equal(itemIterator.Key(), LegacyDeploymentItemKey(addr, meta.GetName(), itemMeta.GetPath())) {
+				continue
+			}
+
+			var content types.ItemContent
+			if b := legacyItemContentStore.Get(itemIterator.Key()); b != nil {
+				if err := cdc.Unmarshal(b, &content); err != nil {
+					itemIterator.Close()
+					return err
+				}
+			}
+			itemMeta.Hash = retainBlob(store, content.GetContent())
+			itemMeta.Size_ = uint64(len(content.GetContent()))
+			if itemMeta.ContentType == "" {
+				itemMeta.ContentType = types.DetectContentType(itemMeta.GetPath(), content.GetContent())
+			}
+			meta.TotalSize += itemMeta.Size_
+
+			b, err := cdc.Marshal(&itemMeta)
+			if err != nil {
+				itemIterator.Close()
+				return err
+			}
+			itemMetaStore.Set(types.DeploymentItemKey(addr, meta.GetName(), itemMeta.GetPath()), b)
+		}
+		itemIterator.Close()
+
+		seq++
+		meta.Id = seq
+		meta.Version = 1
+		b, err := cdc.Marshal(&meta)
+		if err != nil {
+			return err
+		}
+		deploymentKey := types.DeploymentKey(addr, meta.GetName())
+		metaStore.Set(deploymentKey, b)
+		idStore.Set(types.DeploymentIdKey(seq), deploymentKey)
+	}
+
+	store.Set(types.DeploymentSeqKey, sdk.Uint64ToBigEndian(seq))
+	return nil
+}
+
+// retainBlob stores a content in the blob store, as is, and increments its reference count.
+func retainBlob(store storetypes.KVStore, content []byte) []byte {
+	blobStore := prefix.NewStore(store, types.BlobKeyPrefix)
+	refCountStore := prefix.NewStore(store, types.BlobRefCountKeyPrefix)
+
+	hash := types.ContentHash(content)
+	var refCount uint64
+	if b := refCountStore.Get(hash); b != nil {
+		refCount = sdk.BigEndianToUint64(b)
+	} else {
+		blobStore.Set(hash, append([]byte{}, content...))
+	}
+	refCountStore.Set(hash, sdk.Uint64ToBigEndian(refCount+1))
+
+	return hash
+}
+
+// indexDomains adds the domains of the deployments to the domain index. The creation order of legacy deployments
+// being unknown, the first deployment in key order wins and the domain of the others is cleared.
+func indexDomains(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	metaStore := prefix.NewStore(store, types.DeploymentMetaKeyPrefix)
+	domainStore := prefix.NewStore(store, types.DeploymentDomainKeyPrefix)
+
+	iterator := metaStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	// Metas are rewritten once the iteration is over
+	var (
+		keys  [][]byte
+		metas []*types.Meta
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var meta types.Meta
+		if err := cdc.Unmarshal(iterator.Value(), &meta); err != nil {
+			return err
+		}
+		if meta.GetDomain() == "" {
+			continue
+		}
+
+		domainKey := types.DomainKey(meta.GetDomain())
+		if domainStore.Has(domainKey) {
+			meta.Domain = ""
+			keys = append(keys, iterator.Key())
+			metas = append(metas, &meta)
+			continue
+		}
+		domainStore.Set(domainKey, iterator.Key())
+	}
+
+	for i, key := range keys {
+		metaStore.Set(key, cdc.MustMarshal(metas[i]))
+	}
+
+	return nil
+}
+
+// indexDeployments indexes the deployments by creation height, update height, name and total size. The creation and
+// update heights of legacy deployments are unknown and left to 0.
+func indexDeployments(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	metaStore := prefix.NewStore(store, types.DeploymentMetaKeyPrefix)
+	creationHeightStore := prefix.NewStore(store, types.DeploymentCreationHeightKeyPrefix)
+	updateHeightStore := prefix.NewStore(store, types.DeploymentUpdateHeightKeyPrefix)
+	nameStore := prefix.NewStore(store, types.DeploymentNameKeyPrefix)
+	totalSizeStore := prefix.NewStore(store, types.DeploymentTotalSizeKeyPrefix)
+
+	iterator := metaStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var meta types.Meta
+		if err := cdc.Unmarshal(iterator.Value(), &meta); err != nil {
+			return err
+		}
+		addr, err := sdk.AccAddressFromBech32(meta.GetCreator())
+		if err != nil {
+			return err
+		}
+		creationHeightStore.Set(types.CreationHeightKey(meta.GetCreatedAtHeight(), addr, meta.GetName()), []byte{})
+		updateHeightStore.Set(types.UpdateHeightKey(meta.GetUpdatedAtHeight(), addr, meta.GetName()), iterator.Key())
+		nameStore.Set(types.NameKey(addr, meta.GetName()), iterator.Key())
+		totalSizeStore.Set(types.TotalSizeKey(meta.GetTotalSize(), addr, meta.GetName()), iterator.Key())
+	}
+
+	return nil
+}
+
+// recordRevisions records the current files of each deployment as its first revision.
+func recordRevisions(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	metaStore := prefix.NewStore(store, types.DeploymentMetaKeyPrefix)
+	itemMetaStore := prefix.NewStore(store, types.DeploymentItemMetaPrefix)
+	refCountStore := prefix.NewStore(store, types.BlobRefCountKeyPrefix)
+	revisionStore := prefix.NewStore(store, types.RevisionKeyPrefix)
+
+	metaIterator := metaStore.Iterator(nil, nil)
+	var deploymentKeys [][]byte
+	for ; metaIterator.Valid(); metaIterator.Next() {
+		deploymentKeys = append(deploymentKeys, metaIterator.Key())
+	}
+	metaIterator.Close()
+
+	for _, deploymentKey := range deploymentKeys {
+		items := make([]*types.ItemMeta, 0)
+		iterator := sdk.KVStorePrefixIterator(itemMetaStore, deploymentKey)
+		for ; iterator.Valid(); iterator.Next() {
+			var meta types.ItemMeta
+			if err := cdc.Unmarshal(iterator.Value(), &meta); err != nil {
+				iterator.Close()
+				return err
+			}
+			items = append(items, &meta)
+		}
+		iterator.Close()
+
+		for _, item := range items {
+			refCount := sdk.BigEndianToUint64(refCountStore.Get(item.GetHash()))
+			refCountStore.Set(item.GetHash(), sdk.Uint64ToBigEndian(refCount+1))
+		}
+
+		b, err := cdc.Marshal(&types.Revision{
+			Number: 1,
+			Height: ctx.BlockHeight(),
+			Hash:   types.DatasetHash(items),
+			Items:  items,
+		})
+		if err != nil {
+			return err
+		}
+		revisionStore.Set(append(append([]byte{}, deploymentKey...), sdk.Uint64ToBigEndian(1)...), b)
+	}
+
+	return nil
+}
+
+// migrateParams moves the params from the legacy x/params subspace to the module store. Params missing from the
+// subspace are set to their default value.
+func migrateParams(ctx sdk.Context, store storetypes.KVStore, legacySubspace exported.Subspace, cdc codec.BinaryCodec) error {
+	params := types.DefaultParams()
+	legacySubspace.GetParamSetIfExists(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+	return nil
+}
+
+func deletePrefix(store storetypes.KVStore, p []byte) {
+	s := prefix.NewStore(store, p)
+	iterator := s.Iterator(nil, nil)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		s.Delete(key)
+	}
+}
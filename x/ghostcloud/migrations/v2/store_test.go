@@ -0,0 +1,237 @@
+package v2_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/exported"
+	v2 "ghostcloud/x/ghostcloud/migrations/v2"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mockSubspace struct {
+	set func(p *types.Params)
+}
+
+func (ms mockSubspace) GetParamSetIfExists(_ sdk.Context, ps exported.ParamSet) {
+	if ms.set != nil {
+		ms.set(ps.(*types.Params))
+	}
+}
+
+func setLegacyItem(store storetypes.KVStore, cdc codec.BinaryCodec, addr sdk.AccAddress, name string, path string, content string) {
+	key := v2.LegacyDeploymentItemKey(addr, name, path)
+	prefix.NewStore(store, v2.LegacyDeploymentItemMetaPrefix).Set(key, cdc.MustMarshal(&types.ItemMeta{Path: path}))
+	prefix.NewStore(store, v2.LegacyDeploymentItemContentPrefix).Set(key, cdc.MustMarshal(&types.ItemContent{Content: []byte(content)}))
+}
+
+func setLegacyMeta(store storetypes.KVStore, cdc codec.BinaryCodec, addr sdk.AccAddress, meta *types.Meta) {
+	prefix.NewStore(store, v2.LegacyDeploymentMetaKeyPrefix).Set(v2.LegacyDeploymentKey(addr, meta.Name), cdc.MustMarshal(meta))
+}
+
+func getItemContent(store storetypes.KVStore, cdc codec.BinaryCodec, addr sdk.AccAddress, name string, path string) (string, bool) {
+	key := types.DeploymentItemKey(addr, name, path)
+	if !prefix.NewStore(store, types.DeploymentItemMetaPrefix).Has(key) {
+		return "", false
+	}
+	var meta types.ItemMeta
+	cdc.MustUnmarshal(prefix.NewStore(store, types.DeploymentItemMetaPrefix).Get(key), &meta)
+	return string(prefix.NewStore(store, types.BlobKeyPrefix).Get(meta.Hash)), true
+}
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	creator := sample.AccAddress()
+	addr := sdk.MustAccAddressFromBech32(creator)
+
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: creator, Name: "a", Domain: "a.com"})
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: creator, Name: "ab"})
+	// Follows "a" in key order and shares its domain
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: creator, Name: "b", Domain: "A.com"})
+
+	setLegacyItem(store, cdc, addr, "a", "index.html", "a")
+	setLegacyItem(store, cdc, addr, "ab", "index.html", "ab")
+	// Collides with name "ab" and path "c", only the latter survives in the legacy layout
+	setLegacyItem(store, cdc, addr, "a", "bc", "a/bc")
+	setLegacyItem(store, cdc, addr, "ab", "c", "ab/c")
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, mockSubspace{}, cdc))
+
+	// Metas
+	metaStore := prefix.NewStore(store, types.DeploymentMetaKeyPrefix)
+	expected := []types.Meta{
+		{Creator: creator, Name: "a", Domain: "a.com", Id: 1, Version: 1, TotalSize: 1},
+		{Creator: creator, Name: "ab", Id: 2, Version: 1, TotalSize: 6},
+		{Creator: creator, Name: "b", Id: 3, Version: 1},
+	}
+	for _, want := range expected {
+		deploymentKey := types.DeploymentKey(addr, want.Name)
+		var meta types.Meta
+		cdc.MustUnmarshal(metaStore.Get(deploymentKey), &meta)
+		require.Equal(t, want, meta)
+		require.Equal(t, deploymentKey, prefix.NewStore(store, types.DeploymentIdKeyPrefix).Get(types.DeploymentIdKey(want.Id)))
+	}
+	require.Equal(t, uint64(3), sdk.BigEndianToUint64(store.Get(types.DeploymentSeqKey)))
+
+	// Items
+	content, found := getItemContent(store, cdc, addr, "a", "index.html")
+	require.True(t, found)
+	require.Equal(t, "a", content)
+	content, found = getItemContent(store, cdc, addr, "ab", "index.html")
+	require.True(t, found)
+	require.Equal(t, "ab", content)
+	content, found = getItemContent(store, cdc, addr, "ab", "c")
+	require.True(t, found)
+	require.Equal(t, "ab/c", content)
+	_, found = getItemContent(store, cdc, addr, "a", "bc")
+	require.False(t, found)
+	_, found = getItemContent(store, cdc, addr, "a", "bindex.html")
+	require.False(t, found)
+
+	var item types.ItemMeta
+	cdc.MustUnmarshal(prefix.NewStore(store, types.DeploymentItemMetaPrefix).Get(types.DeploymentItemKey(addr, "ab", "index.html")), &item)
+	require.Equal(t, types.ItemMeta{
+		Path:        "index.html",
+		Hash:        types.ContentHash([]byte("ab")),
+		Size_:       2,
+		ContentType: "text/html; charset=utf-8",
+	}, item)
+
+	// Domain index
+	domainStore := prefix.NewStore(store, types.DeploymentDomainKeyPrefix)
+	addrGot, name, _, err := types.ParseDeploymentKey(domainStore.Get(types.DomainKey("a.com")))
+	require.NoError(t, err)
+	require.Equal(t, addr, addrGot)
+	require.Equal(t, "a", name)
+
+	// Legacy state is removed
+	for _, p := range [][]byte{
+		v2.LegacyDeploymentMetaKeyPrefix,
+		v2.LegacyDeploymentItemMetaPrefix,
+		v2.LegacyDeploymentItemContentPrefix,
+	} {
+		iterator := sdk.KVStorePrefixIterator(store, p)
+		require.False(t, iterator.Valid())
+		iterator.Close()
+	}
+}
+
+func TestMigrateStoreIndexes(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: addr.String(), Name: "foo"})
+	setLegacyItem(store, cdc, addr, "foo", "index.html", "index")
+	setLegacyItem(store, cdc, addr, "foo", "app.js", "app")
+	// The items of a deployment whose name shares a prefix are not counted
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: addr.String(), Name: "foobar"})
+	setLegacyItem(store, cdc, addr, "foobar", "index.html", "foobar")
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, mockSubspace{}, cdc))
+
+	for name, totalSize := range map[string]uint64{"foo": 8, "foobar": 6} {
+		deploymentKey := types.DeploymentKey(addr, name)
+		require.True(t, prefix.NewStore(store, types.DeploymentCreationHeightKeyPrefix).Has(types.CreationHeightKey(0, addr, name)))
+		require.Equal(t, deploymentKey, prefix.NewStore(store, types.DeploymentUpdateHeightKeyPrefix).Get(types.UpdateHeightKey(0, addr, name)))
+		require.Equal(t, deploymentKey, prefix.NewStore(store, types.DeploymentNameKeyPrefix).Get(types.NameKey(addr, name)))
+		require.Equal(t, deploymentKey, prefix.NewStore(store, types.DeploymentTotalSizeKeyPrefix).Get(types.TotalSizeKey(totalSize, addr, name)))
+	}
+}
+
+func TestMigrateStoreRevisions(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	store := ctx.KVStore(storeKey)
+	refCountStore := prefix.NewStore(store, types.BlobRefCountKeyPrefix)
+	revisionStore := prefix.NewStore(store, types.RevisionKeyPrefix)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: addr.String(), Name: "foo"})
+	setLegacyItem(store, cdc, addr, "foo", "app.js", "app")
+	setLegacyItem(store, cdc, addr, "foo", "index.html", "index")
+	// Shares the content of the index of "foo"
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: addr.String(), Name: "bar"})
+	setLegacyItem(store, cdc, addr, "bar", "index.html", "index")
+	setLegacyMeta(store, cdc, addr, &types.Meta{Creator: addr.String(), Name: "baz"})
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey, mockSubspace{}, cdc))
+
+	var revision types.Revision
+	cdc.MustUnmarshal(revisionStore.Get(types.RevisionKey(addr, "foo", 1)), &revision)
+	require.Equal(t, uint64(1), revision.Number)
+	require.Len(t, revision.Items, 2)
+	require.Equal(t, types.ContentHash([]byte("app")), revision.Items[0].Hash)
+	require.Equal(t, types.ContentHash([]byte("index")), revision.Items[1].Hash)
+	require.Equal(t, types.DatasetHash(revision.Items), revision.Hash)
+
+	// Each content is referenced by the items and by the revisions
+	require.Equal(t, uint64(2), sdk.BigEndianToUint64(refCountStore.Get(types.ContentHash([]byte("app")))))
+	require.Equal(t, uint64(4), sdk.BigEndianToUint64(refCountStore.Get(types.ContentHash([]byte("index")))))
+
+	var emptyRevision types.Revision
+	cdc.MustUnmarshal(revisionStore.Get(types.RevisionKey(addr, "baz", 1)), &emptyRevision)
+	require.Empty(t, emptyRevision.Items)
+}
+
+func TestMigrateStoreParams(t *testing.T) {
+	tests := []struct {
+		name     string
+		set      func(p *types.Params)
+		expected func(p *types.Params)
+		err      string
+	}{
+		{
+			name:     "empty subspace",
+			set:      func(p *types.Params) {},
+			expected: func(p *types.Params) {},
+		},
+		{
+			name:     "legacy params",
+			set:      func(p *types.Params) { p.MaxNameSize = 64 },
+			expected: func(p *types.Params) { p.MaxNameSize = 64 },
+		},
+		{
+			name: "invalid legacy params",
+			set:  func(p *types.Params) { p.MaxNameSize = types.MaxNameSizeLimit + 1 },
+			err:  "max name size is too big",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storeKey := sdk.NewKVStoreKey(types.StoreKey)
+			ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+			err := v2.MigrateStore(ctx, storeKey, mockSubspace{set: tt.set}, cdc)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				require.Nil(t, ctx.KVStore(storeKey).Get(types.ParamsKey))
+				return
+			}
+			require.NoError(t, err)
+
+			expected := types.DefaultParams()
+			tt.expected(&expected)
+			var params types.Params
+			cdc.MustUnmarshal(ctx.KVStore(storeKey).Get(types.ParamsKey), &params)
+			require.Equal(t, expected, params)
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package types
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/klauspost/compress/zstd"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// zstdMaxWindowSize is the maximum window size of zstd-compressed archives, i.e., the window size of the highest
+// standard compression level. It bounds the memory used to decompress an archive.
+const zstdMaxWindowSize = 8 << 20
+
+// limitedReader reads at most max bytes from a reader, and fails once more bytes are read.
+type limitedReader struct {
+	r    io.Reader
+	read uint64
+	max  uint64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	// Read one byte past the maximum at most, to tell a stream of exactly the maximum size apart from a bigger one
+	if l.max-l.read < uint64(len(p)) {
+		p = p[:l.max-l.read+1]
+	}
+	n, err := l.r.Read(p)
+	l.read += uint64(n)
+	if l.read > l.max {
+		// Partial reads are dropped so that the error is not ignored by callers reading full blocks
+		return 0, fmt.Errorf(UncompressedSizeTooBig, l.read, l.max)
+	}
+	return n, err
+}
+
+// NewTarReader returns a reader of the tarball of a TarGz or TarZstd archive. Reading more than maxStreamSize bytes
+// of the decompressed tarball, headers included, fails. The returned closer releases the decompressor.
+func NewTarReader(archive *Archive, maxStreamSize uint64) (*tar.Reader, io.Closer, error) {
+	var decompressor io.ReadCloser
+	switch archive.Type {
+	case ArchiveType_TarGz:
+		r, err := gzip.NewReader(bytes.NewReader(archive.Content))
+		if err != nil {
+			return nil, nil, fmt.Errorf("gzip reader error: %w", err)
+		}
+		decompressor = r
+	case ArchiveType_TarZstd:
+		r, err := zstd.NewReader(bytes.NewReader(archive.Content),
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+			zstd.WithDecoderMaxWindow(zstdMaxWindowSize),
+		)
+		if err != nil {
+			return nil, nil, fmt.Errorf("zstd reader error: %w", err)
+		}
+		decompressor = r.IOReadCloser()
+	default:
+		return nil, nil, fmt.Errorf("unsupported archive type: %s", archive.Type)
+	}
+
+	return tar.NewReader(&limitedReader{r: decompressor, max: maxStreamSize}), decompressor, nil
+}
+
+// IsTarFile returns true if the tar entry is a regular file. Directories and global headers are skipped by readers,
+// other entries, e.g., links, are not supported.
+func IsTarFile(header *tar.Header) (bool, error) {
+	switch header.Typeflag {
+	case tar.TypeReg:
+		return true, nil
+	case tar.TypeDir, tar.TypeXGlobalHeader:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported archive entry: %s", header.Name)
+	}
+}
+
+func datasetFromTar(archive *Archive) (*Dataset, error) {
+	tarReader, closer, err := NewTarReader(archive, math.MaxUint64)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	items := make([]*Item, 0)
+	paths := make(map[string]struct{})
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tar reader error: %w", err)
+		}
+		if ok, err := IsTarFile(header); err != nil {
+			return nil, err
+		} else if !ok {
+			continue
+		}
+
+		// Entries are recorded relative to the root of the archive, e.g., `./assets/app.js` as `assets/app.js`
+		itemPath, err := CanonicalPath(header.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := paths[itemPath]; ok {
+			return nil, errorsmod.Wrap(ErrDuplicatePath, itemPath)
+		}
+		paths[itemPath] = struct{}{}
+
+		content, err := io.ReadAll(tarReader)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file: %w", err)
+		}
+		items = append(items, &Item{
+			Meta:    &ItemMeta{Path: itemPath},
+			Content: &ItemContent{Content: content},
+		})
+	}
+
+	return &Dataset{
+		Items: items,
+	}, nil
+}
+
+func datasetFromZip(content []byte) (*Dataset, error) {
+	r := bytes.NewReader(content)
+	zipReader, err := zip.NewReader(r, int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("zip reader error: %w", err)
+	}
+
+	items := make([]*Item, 0, len(zipReader.File))
+	paths := make(map[string]struct{})
+	for _, file := range zipReader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		// Entries are recorded relative to the root of the archive, e.g., `./assets/app.js` as `assets/app.js`
+		itemPath, err := CanonicalPath(file.Name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := paths[itemPath]; ok {
+			return nil, errorsmod.Wrap(ErrDuplicatePath, itemPath)
+		}
+		paths[itemPath] = struct{}{}
+
+		ferr := func(f *zip.File) error {
+			rc, oerr := file.Open()
+			if oerr != nil {
+				return fmt.Errorf("error opening file: %w", oerr)
+			}
+			defer rc.Close()
+
+			content, rerr := io.ReadAll(rc)
+			if rerr != nil {
+				return fmt.Errorf("error reading file: %w", rerr)
+			}
+
+			items = append(items, &Item{
+				Meta:    &ItemMeta{Path: itemPath},
+				Content: &ItemContent{Content: content},
+			})
+			return nil
+		}(file)
+		if ferr != nil {
+			return nil, fmt.Errorf("error processing file: %w", ferr)
+		}
+	}
+
+	return &Dataset{
+		Items: items,
+	}, nil
+}
+
+// DatasetFromArchive extracts the files of an archive.
+func DatasetFromArchive(archive *Archive) (*Dataset, error) {
+	switch archive.Type {
+	case ArchiveType_Zip:
+		return datasetFromZip(archive.Content)
+	case ArchiveType_TarGz, ArchiveType_TarZstd:
+		return datasetFromTar(archive)
+	default:
+		return nil, fmt.Errorf("unsupported archive type: %s", archive.Type)
+	}
+}
@@ -27,14 +27,22 @@ type ArchiveType int32
 
 const (
 	ArchiveType_Zip ArchiveType = 0
+	// TarGz is a gzip-compressed tarball.
+	ArchiveType_TarGz ArchiveType = 1
+	// TarZstd is a zstd-compressed tarball.
+	ArchiveType_TarZstd ArchiveType = 2
 )
 
 var ArchiveType_name = map[int32]string{
 	0: "Zip",
+	1: "TarGz",
+	2: "TarZstd",
 }
 
 var ArchiveType_value = map[string]int32{
-	"Zip": 0,
+	"Zip":     0,
+	"TarGz":   1,
+	"TarZstd": 2,
 }
 
 func (x ArchiveType) String() string {
@@ -107,18 +115,20 @@ func init() {
 }
 
 var fileDescriptor_6f1c69d3f5bc285c = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
+	// 194 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xcf, 0xc8, 0x2f,
 	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0x26, 0x16, 0x25, 0x67, 0x64, 0x96, 0xa5,
 	0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x64, 0xf4, 0x10, 0x4c, 0xa5, 0x68, 0x2e,
 	0x76, 0x47, 0x88, 0x3a, 0x21, 0x33, 0x2e, 0x96, 0x92, 0xca, 0x82, 0x54, 0x09, 0x46, 0x05, 0x46,
 	0x0d, 0x3e, 0x23, 0x25, 0x3d, 0xac, 0x1a, 0xf4, 0xa0, 0xaa, 0x43, 0x2a, 0x0b, 0x52, 0x83, 0xc0,
 	0xea, 0x85, 0x24, 0xb8, 0xd8, 0x93, 0xf3, 0xf3, 0x4a, 0x52, 0xf3, 0x4a, 0x24, 0x98, 0x14, 0x18,
-	0x35, 0x78, 0x82, 0x60, 0x5c, 0x2d, 0x31, 0x2e, 0x6e, 0x24, 0xe5, 0x42, 0xec, 0x5c, 0xcc, 0x51,
-	0x99, 0x05, 0x02, 0x0c, 0x4e, 0xe6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x25, 0x8b, 0xe4, 0xfe, 0x0a, 0x64, 0xcf, 0x80, 0x6c, 0x2a, 0x4e, 0x62, 0x03, 0xfb, 0xc5, 0x18,
-	0x10, 0x00, 0x00, 0xff, 0xff, 0xee, 0xee, 0x98, 0xe0, 0xf2, 0x00, 0x00, 0x00,
+	0x35, 0x78, 0x82, 0x60, 0x5c, 0x2d, 0x3d, 0x2e, 0x6e, 0x24, 0xe5, 0x42, 0xec, 0x5c, 0xcc, 0x51,
+	0x99, 0x05, 0x02, 0x0c, 0x42, 0x9c, 0x5c, 0xac, 0x21, 0x89, 0x45, 0xee, 0x55, 0x02, 0x8c, 0x42,
+	0xdc, 0x5c, 0xec, 0x21, 0x89, 0x45, 0x51, 0xc5, 0x25, 0x29, 0x02, 0x4c, 0x4e, 0xe6, 0x27, 0x1e,
+	0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17,
+	0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x8b, 0xe4, 0xaf, 0x0a, 0x64, 0x4f, 0x82,
+	0x5c, 0x50, 0x9c, 0xc4, 0x06, 0xf6, 0xa3, 0x31, 0x60, 0x00, 0xa4, 0x97, 0x09, 0x9a, 0x0a, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Archive) Marshal() (dAtA []byte, err error) {
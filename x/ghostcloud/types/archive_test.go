@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDatasetFromArchive(t *testing.T) {
+	archive := &types.Archive{
+		Type: types.ArchiveType_Zip,
+		Content: sample.CreateZipWithFiles(
+			sample.ArchiveFile{Name: "./index.html", Body: "<h1>index</h1>"},
+			sample.ArchiveFile{Name: "assets/"},
+			sample.ArchiveFile{Name: "assets/app.js", Body: "assets"},
+			sample.ArchiveFile{Name: "lib/./app.js", Body: "lib"},
+		),
+	}
+
+	dataset, err := types.DatasetFromArchive(archive)
+	require.NoError(t, err)
+	require.Equal(t, []*types.Item{
+		{Meta: &types.ItemMeta{Path: "index.html"}, Content: &types.ItemContent{Content: []byte("<h1>index</h1>")}},
+		{Meta: &types.ItemMeta{Path: "assets/app.js"}, Content: &types.ItemContent{Content: []byte("assets")}},
+		{Meta: &types.ItemMeta{Path: "lib/app.js"}, Content: &types.ItemContent{Content: []byte("lib")}},
+	}, dataset.Items)
+}
+
+func TestDatasetFromArchiveDuplicatePath(t *testing.T) {
+	archive := &types.Archive{
+		Type: types.ArchiveType_Zip,
+		Content: sample.CreateZipWithFiles(
+			sample.ArchiveFile{Name: "index.html", Body: "<h1>index</h1>"},
+			sample.ArchiveFile{Name: "./index.html", Body: "<h1>other</h1>"},
+		),
+	}
+
+	_, err := types.DatasetFromArchive(archive)
+	require.ErrorIs(t, err, types.ErrDuplicatePath)
+}
+
+func TestDatasetFromArchiveInvalidPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		err  error
+	}{
+		{name: "traversal", path: "../index.html", err: types.ErrPathTraversal},
+		{name: "absolute", path: "/etc/passwd", err: types.ErrAbsolutePath},
+		{name: "backslash", path: `assets\app.js`, err: types.ErrPathBackslash},
+		{name: "empty segment", path: "assets//app.js", err: types.ErrEmptyPathSegment},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive := &types.Archive{
+				Type: types.ArchiveType_Zip,
+				Content: sample.CreateZipWithFiles(
+					sample.ArchiveFile{Name: "index.html", Body: "<h1>index</h1>"},
+					sample.ArchiveFile{Name: tt.path, Body: "foo"},
+				),
+			}
+
+			_, err := types.DatasetFromArchive(archive)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestDatasetFromTarball(t *testing.T) {
+	for _, archiveType := range []types.ArchiveType{types.ArchiveType_TarGz, types.ArchiveType_TarZstd} {
+		t.Run(archiveType.String(), func(t *testing.T) {
+			archive := &types.Archive{
+				Type: archiveType,
+				Content: sample.CreateTarballWithFiles(archiveType,
+					sample.ArchiveFile{Name: "./"},
+					sample.ArchiveFile{Name: "./index.html", Body: "<h1>index</h1>"},
+					sample.ArchiveFile{Name: "./assets/"},
+					sample.ArchiveFile{Name: "./assets/app.js", Body: "assets"},
+					sample.ArchiveFile{Name: "lib/app.js", Body: "lib"},
+					sample.ArchiveFile{Name: "empty.txt"},
+				),
+			}
+
+			dataset, err := types.DatasetFromArchive(archive)
+			require.NoError(t, err)
+			require.Equal(t, []*types.Item{
+				{Meta: &types.ItemMeta{Path: "index.html"}, Content: &types.ItemContent{Content: []byte("<h1>index</h1>")}},
+				{Meta: &types.ItemMeta{Path: "assets/app.js"}, Content: &types.ItemContent{Content: []byte("assets")}},
+				{Meta: &types.ItemMeta{Path: "lib/app.js"}, Content: &types.ItemContent{Content: []byte("lib")}},
+				{Meta: &types.ItemMeta{Path: "empty.txt"}, Content: &types.ItemContent{Content: []byte{}}},
+			}, dataset.Items)
+		})
+	}
+}
+
+func TestDatasetFromTarballInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		archive *types.Archive
+		err     string
+	}{
+		{
+			name: "traversal",
+			archive: &types.Archive{Type: types.ArchiveType_TarGz, Content: sample.CreateTarballWithFiles(types.ArchiveType_TarGz,
+				sample.ArchiveFile{Name: "../index.html"},
+			)},
+			err: types.ErrPathTraversal.Error(),
+		},
+		{
+			name: "duplicate",
+			archive: &types.Archive{Type: types.ArchiveType_TarZstd, Content: sample.CreateTarballWithFiles(types.ArchiveType_TarZstd,
+				sample.ArchiveFile{Name: "index.html"},
+				sample.ArchiveFile{Name: "./index.html"},
+			)},
+			err: types.ErrDuplicatePath.Error(),
+		},
+		{
+			name:    "not a tarball",
+			archive: &types.Archive{Type: types.ArchiveType_TarGz, Content: sample.CreateZip("index.html", "foobar")},
+			err:     "gzip reader error",
+		},
+		{
+			name:    "wrong compression",
+			archive: &types.Archive{Type: types.ArchiveType_TarZstd, Content: sample.CreateTarballWithFiles(types.ArchiveType_TarGz, sample.ArchiveFile{Name: "index.html"})},
+			err:     "tar reader error",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := types.DatasetFromArchive(tt.archive)
+			require.ErrorContains(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PublishAuthorization{}
+
+// PublishMsgTypeURLs returns the type URLs of the messages a PublishAuthorization can grant.
+func PublishMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgCreateDeploymentRequest{}),
+		sdk.MsgTypeURL(&MsgUpdateDeploymentRequest{}),
+		sdk.MsgTypeURL(&MsgPatchDeploymentRequest{}),
+	}
+}
+
+// uploadMsgTypeURLs returns the type URLs of the upload session messages. They are not granted by a
+// PublishAuthorization: chunks and commits only refer to a session id, so the grant could not restrict their
+// deployment name nor bound the total size of the upload.
+func uploadMsgTypeURLs() []string {
+	return []string{
+		sdk.MsgTypeURL(&MsgBeginUploadRequest{}),
+		sdk.MsgTypeURL(&MsgUploadChunkRequest{}),
+		sdk.MsgTypeURL(&MsgCommitUploadRequest{}),
+	}
+}
+
+// NewPublishAuthorization creates a new PublishAuthorization of the given message, restricted to the deployments of
+// the given creator, if any, the given deployment names and maximum payload size.
+func NewPublishAuthorization(msgTypeURL string, creator string, names []string, maxPayloadSize int64) *PublishAuthorization {
+	return &PublishAuthorization{
+		MsgTypeUrl:     msgTypeURL,
+		Creator:        creator,
+		Names:          names,
+		MaxPayloadSize: maxPayloadSize,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PublishAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept. The message is accepted if it publishes one of the allowed deployments of the
+// allowed creator with a payload of at most the maximum payload size.
+func (a PublishAuthorization) Accept(_ sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	var creator, name string
+	var size int
+	switch msg := msg.(type) {
+	case *MsgCreateDeploymentRequest:
+		creator, name, size = msg.GetMeta().GetCreator(), msg.GetMeta().GetName(), msg.GetPayload().Size()
+	case *MsgUpdateDeploymentRequest:
+		creator, name, size = msg.GetMeta().GetCreator(), msg.GetMeta().GetName(), msg.GetPayload().Size()
+	case *MsgPatchDeploymentRequest:
+		creator, name, size = msg.GetCreator(), msg.GetName(), msg.GetUpsert().Size()
+	case *MsgBeginUploadRequest, *MsgUploadChunkRequest, *MsgCommitUploadRequest:
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(UploadNotDelegable, a.MsgTypeUrl)
+	default:
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Creator != "" && creator != a.Creator {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("deployments of %s are not allowed by the grant", creator)
+	}
+	if len(a.Names) > 0 && !containsString(a.Names, name) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("deployment %s is not allowed by the grant", name)
+	}
+	if a.MaxPayloadSize > 0 && int64(size) > a.MaxPayloadSize {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(PayloadTooBig, size, a.MaxPayloadSize)
+	}
+
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PublishAuthorization) ValidateBasic() error {
+	if containsString(uploadMsgTypeURLs(), a.MsgTypeUrl) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, UploadNotDelegable, a.MsgTypeUrl)
+	}
+	if !containsString(PublishMsgTypeURLs(), a.MsgTypeUrl) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid publish message type: %s", a.MsgTypeUrl)
+	}
+	if a.Creator != "" {
+		if _, err := sdk.AccAddressFromBech32(a.Creator); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+		}
+	}
+	if a.MaxPayloadSize < 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "max payload size should not be negative: %d", a.MaxPayloadSize)
+	}
+
+	names := make(map[string]struct{}, len(a.Names))
+	for _, name := range a.Names {
+		if name == "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, NameShouldNotBeEmpty)
+		}
+		if _, ok := names[name]; ok {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate deployment name: %s", name)
+		}
+		names[name] = struct{}{}
+	}
+
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
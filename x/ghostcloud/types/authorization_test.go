@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	createTypeURL = sdk.MsgTypeURL(&types.MsgCreateDeploymentRequest{})
+	updateTypeURL = sdk.MsgTypeURL(&types.MsgUpdateDeploymentRequest{})
+	patchTypeURL  = sdk.MsgTypeURL(&types.MsgPatchDeploymentRequest{})
+)
+
+func TestPublishAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		auth *types.PublishAuthorization
+		err  error
+	}{
+		{
+			name: "valid",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", []string{"foo", "bar"}, 1024),
+		}, {
+			name: "any name",
+			auth: types.NewPublishAuthorization(patchTypeURL, "", nil, 0),
+		}, {
+			name: "not a publishing message",
+			auth: types.NewPublishAuthorization(sdk.MsgTypeURL(&types.MsgRemoveDeploymentRequest{}), "", nil, 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "upload session message",
+			auth: types.NewPublishAuthorization(sdk.MsgTypeURL(&types.MsgBeginUploadRequest{}), "", []string{"foo"}, 1024),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "creator",
+			auth: types.NewPublishAuthorization(updateTypeURL, sample.AccAddress(), nil, 0),
+		}, {
+			name: "invalid creator",
+			auth: types.NewPublishAuthorization(updateTypeURL, "invalid", nil, 0),
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "negative max payload size",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", nil, -1),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty name",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", []string{""}, 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "duplicate name",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", []string{"foo", "foo"}, 0),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.auth.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPublishAuthorization_Accept(t *testing.T) {
+	creator := sample.AccAddress()
+	// The granter collaborates on the deployments of several creators
+	collaborator, other := sample.AccAddress(), sample.AccAddress()
+	payload := &types.Payload{PayloadOption: &types.Payload_Dataset{Dataset: &types.Dataset{Items: []*types.Item{
+		{Meta: &types.ItemMeta{Path: "index.html"}, Content: &types.ItemContent{Content: []byte(sample.HelloWorldHTMLBody)}},
+	}}}}
+
+	tests := []struct {
+		name string
+		auth *types.PublishAuthorization
+		msg  sdk.Msg
+		err  error
+	}{
+		{
+			name: "create",
+			auth: types.NewPublishAuthorization(createTypeURL, "", []string{"foo"}, 0),
+			msg:  &types.MsgCreateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}, Payload: payload},
+		}, {
+			name: "update",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", []string{"foo"}, int64(payload.Size())),
+			msg:  &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}, Payload: payload},
+		}, {
+			name: "patch",
+			auth: types.NewPublishAuthorization(patchTypeURL, "", nil, 0),
+			msg:  &types.MsgPatchDeploymentRequest{Creator: creator, Name: "bar", Upsert: payload.GetDataset()},
+		}, {
+			name: "creator allowed",
+			auth: types.NewPublishAuthorization(updateTypeURL, creator, []string{"foo"}, 0),
+			msg:  &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}, Payload: payload, Signer: collaborator},
+		}, {
+			name: "creator not allowed",
+			auth: types.NewPublishAuthorization(updateTypeURL, creator, []string{"foo"}, 0),
+			msg:  &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: other, Name: "foo"}, Payload: payload, Signer: collaborator},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "patch creator not allowed",
+			auth: types.NewPublishAuthorization(patchTypeURL, creator, nil, 0),
+			msg:  &types.MsgPatchDeploymentRequest{Creator: other, Name: "foo", Upsert: payload.GetDataset(), Signer: collaborator},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "name not allowed",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", []string{"foo"}, 0),
+			msg:  &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "bar"}, Payload: payload},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "payload too big",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", nil, int64(payload.Size())-1),
+			msg:  &types.MsgUpdateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}, Payload: payload},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "upload session message",
+			auth: types.NewPublishAuthorization(sdk.MsgTypeURL(&types.MsgCommitUploadRequest{}), "", nil, 0),
+			msg:  &types.MsgCommitUploadRequest{Creator: creator, SessionId: 1},
+			err:  sdkerrors.ErrUnauthorized,
+		}, {
+			name: "type mismatch",
+			auth: types.NewPublishAuthorization(updateTypeURL, "", nil, 0),
+			msg:  &types.MsgCreateDeploymentRequest{Meta: &types.Meta{Creator: creator, Name: "foo"}, Payload: payload},
+			err:  sdkerrors.ErrInvalidType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.auth.Accept(sdk.Context{}, tt.msg)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.True(t, resp.Accept)
+			require.False(t, resp.Delete)
+		})
+	}
+}
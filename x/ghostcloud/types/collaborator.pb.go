@@ -0,0 +1,498 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ghostcloud/ghostcloud/collaborator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CollaboratorRole is the role of a collaborator on a deployment.
+type CollaboratorRole int32
+
+const (
+	CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED CollaboratorRole = 0
+	// COLLABORATOR_ROLE_EDITOR may update the files of the deployment, but not its domain or description.
+	CollaboratorRole_COLLABORATOR_ROLE_EDITOR CollaboratorRole = 1
+	// COLLABORATOR_ROLE_ADMIN may also update the domain and the description, and add and remove collaborators.
+	CollaboratorRole_COLLABORATOR_ROLE_ADMIN CollaboratorRole = 2
+)
+
+var CollaboratorRole_name = map[int32]string{
+	0: "COLLABORATOR_ROLE_UNSPECIFIED",
+	1: "COLLABORATOR_ROLE_EDITOR",
+	2: "COLLABORATOR_ROLE_ADMIN",
+}
+
+var CollaboratorRole_value = map[string]int32{
+	"COLLABORATOR_ROLE_UNSPECIFIED": 0,
+	"COLLABORATOR_ROLE_EDITOR":      1,
+	"COLLABORATOR_ROLE_ADMIN":       2,
+}
+
+func (x CollaboratorRole) String() string {
+	return proto.EnumName(CollaboratorRole_name, int32(x))
+}
+
+func (CollaboratorRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ddc021c6ab1d583c, []int{0}
+}
+
+// Collaborator grants a role on a deployment to an address other than its
+// creator.
+type Collaborator struct {
+	Creator string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name    string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address string           `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Role    CollaboratorRole `protobuf:"varint,4,opt,name=role,proto3,enum=ghostcloud.ghostcloud.CollaboratorRole" json:"role,omitempty"`
+}
+
+func (m *Collaborator) Reset()         { *m = Collaborator{} }
+func (m *Collaborator) String() string { return proto.CompactTextString(m) }
+func (*Collaborator) ProtoMessage()    {}
+func (*Collaborator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ddc021c6ab1d583c, []int{0}
+}
+func (m *Collaborator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Collaborator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Collaborator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Collaborator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Collaborator.Merge(m, src)
+}
+func (m *Collaborator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Collaborator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Collaborator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Collaborator proto.InternalMessageInfo
+
+func (m *Collaborator) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Collaborator) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Collaborator) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Collaborator) GetRole() CollaboratorRole {
+	if m != nil {
+		return m.Role
+	}
+	return CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("ghostcloud.ghostcloud.CollaboratorRole", CollaboratorRole_name, CollaboratorRole_value)
+	proto.RegisterType((*Collaborator)(nil), "ghostcloud.ghostcloud.Collaborator")
+}
+
+func init() {
+	proto.RegisterFile("ghostcloud/ghostcloud/collaborator.proto", fileDescriptor_ddc021c6ab1d583c)
+}
+
+var fileDescriptor_ddc021c6ab1d583c = []byte{
+	// 294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xcf, 0xc8, 0x2f,
+	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0x26, 0xe7, 0xe7, 0xe4, 0x24, 0x26, 0xe5,
+	0x17, 0x25, 0x96, 0xe4, 0x17, 0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0xa4, 0xf5,
+	0x10, 0x4c, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0xe2, 0x78, 0xb0, 0x22, 0x7d, 0x08, 0x07,
+	0xa2, 0x43, 0xe9, 0x38, 0x23, 0x17, 0x8f, 0x33, 0x92, 0x41, 0x42, 0x46, 0x5c, 0xec, 0xc9, 0x45,
+	0xa9, 0x20, 0xa6, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22, 0x50,
+	0x3d, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1, 0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x30,
+	0x85, 0x42, 0x42, 0x5c, 0x2c, 0x79, 0x89, 0xb9, 0xa9, 0x12, 0x4c, 0x20, 0x0d, 0x41, 0x60, 0x36,
+	0xc8, 0x9c, 0x44, 0x88, 0x6a, 0x09, 0x66, 0x42, 0xe6, 0x40, 0x15, 0x0a, 0x59, 0x73, 0xb1, 0x14,
+	0xe5, 0xe7, 0xa4, 0x4a, 0xb0, 0x28, 0x30, 0x6a, 0xf0, 0x19, 0xa9, 0xeb, 0x61, 0xf5, 0x8d, 0x1e,
+	0xb2, 0x73, 0x83, 0xf2, 0x73, 0x52, 0x83, 0xc0, 0x9a, 0xb4, 0x0a, 0xb8, 0x04, 0xd0, 0x65, 0x84,
+	0x14, 0xb9, 0x64, 0x9d, 0xfd, 0x7d, 0x7c, 0x1c, 0x9d, 0xfc, 0x83, 0x1c, 0x43, 0xfc, 0x83, 0xe2,
+	0x83, 0xfc, 0x7d, 0x5c, 0xe3, 0x43, 0xfd, 0x82, 0x03, 0x5c, 0x9d, 0x3d, 0xdd, 0x3c, 0x5d, 0x5d,
+	0x04, 0x18, 0x84, 0x64, 0xb8, 0x24, 0x30, 0x95, 0xb8, 0xba, 0x78, 0x86, 0xf8, 0x07, 0x09, 0x30,
+	0x0a, 0x49, 0x73, 0x89, 0x63, 0xca, 0x3a, 0xba, 0xf8, 0x7a, 0xfa, 0x09, 0x30, 0x39, 0x99, 0x9f,
+	0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31,
+	0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0x52, 0x34, 0x55, 0x20, 0xc7,
+	0x59, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xec, 0x8d, 0x01, 0x03, 0x00, 0x60, 0x07,
+	0x79, 0x9c, 0xd9, 0x01, 0x00, 0x00,
+}
+
+func (m *Collaborator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Collaborator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Collaborator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintCollaborator(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintCollaborator(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCollaborator(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintCollaborator(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCollaborator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCollaborator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Collaborator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovCollaborator(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCollaborator(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCollaborator(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovCollaborator(uint64(m.Role))
+	}
+	return n
+}
+
+func sovCollaborator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCollaborator(x uint64) (n int) {
+	return sovCollaborator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Collaborator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCollaborator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Collaborator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Collaborator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= CollaboratorRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCollaborator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCollaborator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCollaborator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCollaborator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCollaborator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCollaborator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCollaborator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCollaborator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCollaborator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCollaborator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCollaborator = fmt.Errorf("proto: unexpected end of group")
+)
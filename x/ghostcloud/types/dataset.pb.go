@@ -23,8 +23,45 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Encoding is the encoding an item content is stored with.
+type Encoding int32
+
+const (
+	Encoding_ENCODING_IDENTITY Encoding = 0
+	Encoding_ENCODING_BROTLI   Encoding = 1
+)
+
+var Encoding_name = map[int32]string{
+	0: "ENCODING_IDENTITY",
+	1: "ENCODING_BROTLI",
+}
+
+var Encoding_value = map[string]int32{
+	"ENCODING_IDENTITY": 0,
+	"ENCODING_BROTLI":   1,
+}
+
+func (x Encoding) String() string {
+	return proto.EnumName(Encoding_name, int32(x))
+}
+
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6bc760a1c8822668, []int{0}
+}
+
 type ItemMeta struct {
 	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// hash is the SHA-256 hash of the content. It is set by the module.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// size is the size of the content, in bytes. It is set by the module.
+	Size_ uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// content_type is the MIME type of the content. It is detected from the path and the content when not provided.
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// cache_control is the optional Cache-Control header served with the content.
+	CacheControl string `protobuf:"bytes,5,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// content_encoding is the optional Content-Encoding header served with the content, e.g., gzip for a file
+	// compressed ahead of time.
+	ContentEncoding string `protobuf:"bytes,6,opt,name=content_encoding,json=contentEncoding,proto3" json:"content_encoding,omitempty"`
 }
 
 func (m *ItemMeta) Reset()         { *m = ItemMeta{} }
@@ -67,6 +104,41 @@ func (m *ItemMeta) GetPath() string {
 	return ""
 }
 
+func (m *ItemMeta) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *ItemMeta) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+func (m *ItemMeta) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ItemMeta) GetCacheControl() string {
+	if m != nil {
+		return m.CacheControl
+	}
+	return ""
+}
+
+func (m *ItemMeta) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ""
+}
+
 type ItemContent struct {
 	Content []byte `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
 }
@@ -208,6 +280,7 @@ func (m *Dataset) GetItems() []*Item {
 }
 
 func init() {
+	proto.RegisterEnum("ghostcloud.ghostcloud.Encoding", Encoding_name, Encoding_value)
 	proto.RegisterType((*ItemMeta)(nil), "ghostcloud.ghostcloud.ItemMeta")
 	proto.RegisterType((*ItemContent)(nil), "ghostcloud.ghostcloud.ItemContent")
 	proto.RegisterType((*Item)(nil), "ghostcloud.ghostcloud.Item")
@@ -219,22 +292,30 @@ func init() {
 }
 
 var fileDescriptor_6bc760a1c8822668 = []byte{
-	// 230 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xcf, 0xc8, 0x2f,
-	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0xa6, 0x24, 0x96, 0x24, 0x16, 0xa7, 0x96,
-	0xe8, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x64, 0xf4, 0x10, 0x4c, 0x25, 0x39, 0x2e,
-	0x0e, 0xcf, 0x92, 0xd4, 0x5c, 0xdf, 0xd4, 0x92, 0x44, 0x21, 0x21, 0x2e, 0x96, 0x82, 0xc4, 0x92,
-	0x0c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x30, 0x5b, 0x49, 0x9d, 0x8b, 0x1b, 0x24, 0xef,
-	0x9c, 0x9f, 0x57, 0x92, 0x9a, 0x57, 0x22, 0x24, 0xc1, 0xc5, 0x9e, 0x0c, 0x61, 0x82, 0x55, 0xf1,
-	0x04, 0xc1, 0xb8, 0x4a, 0x95, 0x5c, 0x2c, 0x20, 0x85, 0x42, 0xc6, 0x5c, 0x2c, 0xb9, 0xa9, 0x25,
-	0x89, 0x60, 0x69, 0x6e, 0x23, 0x79, 0x3d, 0xac, 0xd6, 0xea, 0xc1, 0xec, 0x0c, 0x02, 0x2b, 0x16,
-	0xb2, 0x41, 0x18, 0xcb, 0x04, 0xd6, 0xa7, 0x84, 0x47, 0x1f, 0xd4, 0x2d, 0x08, 0xab, 0x6d, 0xb8,
-	0xd8, 0x5d, 0x20, 0x7e, 0x15, 0x32, 0xe4, 0x62, 0xcd, 0x2c, 0x49, 0xcd, 0x2d, 0x96, 0x60, 0x54,
-	0x60, 0xd6, 0xe0, 0x36, 0x92, 0xc6, 0x63, 0x4c, 0x10, 0x44, 0xa5, 0x93, 0xf9, 0x89, 0x47, 0x72,
-	0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7,
-	0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xc9, 0x22, 0x05, 0x66, 0x05, 0x72, 0xc8, 0x96, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x03, 0xd6, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x50,
-	0x91, 0x36, 0x7f, 0x01, 0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcb, 0x4a, 0xc3, 0x40,
+	0x18, 0x85, 0x33, 0x6d, 0x7a, 0xf1, 0x4f, 0xa4, 0x75, 0xa4, 0x30, 0x20, 0xc6, 0x98, 0x2e, 0x8c,
+	0x2e, 0x22, 0xb6, 0xa0, 0x9b, 0xae, 0x7a, 0x41, 0x02, 0xda, 0x42, 0xc8, 0x46, 0x37, 0x25, 0xa6,
+	0x43, 0x53, 0x68, 0x93, 0xd0, 0x8c, 0x60, 0x7d, 0x0a, 0x5f, 0xc7, 0x37, 0x70, 0xd9, 0xa5, 0x4b,
+	0x69, 0x5f, 0x44, 0x32, 0x49, 0x4c, 0x17, 0xd2, 0xdd, 0xc9, 0x37, 0x67, 0x4e, 0xe6, 0xbf, 0x40,
+	0x73, 0xea, 0x05, 0x11, 0x73, 0xe7, 0xc1, 0xeb, 0xe4, 0x7a, 0x47, 0x4e, 0x1c, 0xe6, 0x44, 0x94,
+	0x19, 0xe1, 0x32, 0x60, 0x01, 0x6e, 0xe4, 0x27, 0x46, 0x2e, 0xb5, 0x4f, 0x04, 0x55, 0x93, 0xd1,
+	0xc5, 0x23, 0x65, 0x0e, 0xc6, 0x20, 0x86, 0x0e, 0xf3, 0x08, 0x52, 0x91, 0x7e, 0x60, 0x71, 0x1d,
+	0x33, 0xcf, 0x89, 0x3c, 0x52, 0x50, 0x91, 0x2e, 0x5b, 0x5c, 0xc7, 0x2c, 0x9a, 0xbd, 0x53, 0x52,
+	0x54, 0x91, 0x2e, 0x5a, 0x5c, 0xe3, 0x73, 0x90, 0xdd, 0xc0, 0x67, 0xd4, 0x67, 0x63, 0xb6, 0x0a,
+	0x29, 0x11, 0x79, 0x86, 0x94, 0x32, 0x7b, 0x15, 0x52, 0xdc, 0x84, 0x43, 0xd7, 0x71, 0x3d, 0x3a,
+	0x8e, 0xe1, 0x32, 0x98, 0x93, 0x12, 0xf7, 0xc8, 0x1c, 0xf6, 0x12, 0x86, 0x2f, 0xa1, 0x9e, 0xe5,
+	0x50, 0xdf, 0x0d, 0x26, 0x33, 0x7f, 0x4a, 0xca, 0xdc, 0x57, 0x4b, 0xf9, 0x20, 0xc5, 0xda, 0x05,
+	0x48, 0xf1, 0xd3, 0x7b, 0x09, 0xc6, 0x04, 0x2a, 0xa9, 0x83, 0x17, 0x20, 0x5b, 0xd9, 0xa7, 0xb6,
+	0x02, 0x31, 0x36, 0xe2, 0x36, 0x88, 0x0b, 0xca, 0x1c, 0x7e, 0x2c, 0xb5, 0xce, 0x8c, 0x7f, 0x5b,
+	0x62, 0x64, 0xed, 0xb0, 0xb8, 0x19, 0x77, 0xf2, 0xd8, 0x02, 0xbf, 0xa7, 0xed, 0xb9, 0x97, 0xbe,
+	0x25, 0xff, 0x75, 0x07, 0x2a, 0xfd, 0x64, 0x0e, 0xf8, 0x06, 0x4a, 0x33, 0x46, 0x17, 0x11, 0x41,
+	0x6a, 0x51, 0x97, 0x5a, 0x27, 0x7b, 0x62, 0xac, 0xc4, 0x79, 0x75, 0x0b, 0xd5, 0xac, 0x5a, 0xdc,
+	0x80, 0xa3, 0xc1, 0xb0, 0x37, 0xea, 0x9b, 0xc3, 0xfb, 0xb1, 0xd9, 0x1f, 0x0c, 0x6d, 0xd3, 0x7e,
+	0xaa, 0x0b, 0xf8, 0x18, 0x6a, 0x7f, 0xb8, 0x6b, 0x8d, 0xec, 0x07, 0xb3, 0x8e, 0xba, 0x77, 0x5f,
+	0x1b, 0x05, 0xad, 0x37, 0x0a, 0xfa, 0xd9, 0x28, 0xe8, 0x63, 0xab, 0x08, 0xeb, 0xad, 0x22, 0x7c,
+	0x6f, 0x15, 0xe1, 0xf9, 0x74, 0x67, 0x41, 0xde, 0x76, 0xb7, 0x25, 0x1e, 0x5a, 0xf4, 0x52, 0xe6,
+	0xcb, 0xd2, 0xfe, 0x1d, 0x00, 0x5b, 0x3c, 0x6b, 0x77, 0x53, 0x02, 0x00, 0x00,
 }
 
 func (m *ItemMeta) Marshal() (dAtA []byte, err error) {
@@ -257,6 +338,39 @@ func (m *ItemMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContentEncoding) > 0 {
+		i -= len(m.ContentEncoding)
+		copy(dAtA[i:], m.ContentEncoding)
+		i = encodeVarintDataset(dAtA, i, uint64(len(m.ContentEncoding)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CacheControl) > 0 {
+		i -= len(m.CacheControl)
+		copy(dAtA[i:], m.CacheControl)
+		i = encodeVarintDataset(dAtA, i, uint64(len(m.CacheControl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ContentType) > 0 {
+		i -= len(m.ContentType)
+		copy(dAtA[i:], m.ContentType)
+		i = encodeVarintDataset(dAtA, i, uint64(len(m.ContentType)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintDataset(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintDataset(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Path) > 0 {
 		i -= len(m.Path)
 		copy(dAtA[i:], m.Path)
@@ -402,6 +516,25 @@ func (m *ItemMeta) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDataset(uint64(l))
 	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovDataset(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovDataset(uint64(m.Size_))
+	}
+	l = len(m.ContentType)
+	if l > 0 {
+		n += 1 + l + sovDataset(uint64(l))
+	}
+	l = len(m.CacheControl)
+	if l > 0 {
+		n += 1 + l + sovDataset(uint64(l))
+	}
+	l = len(m.ContentEncoding)
+	if l > 0 {
+		n += 1 + l + sovDataset(uint64(l))
+	}
 	return n
 }
 
@@ -517,6 +650,155 @@ func (m *ItemMeta) Unmarshal(dAtA []byte) error {
 			}
 			m.Path = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDataset
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheControl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CacheControl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentEncoding", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDataset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDataset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDataset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentEncoding = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDataset(dAtA[iNdEx:])
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// MinCompressibleSize is the minimum size of a content compressed by the module. Smaller contents are stored as is.
+const MinCompressibleSize = 1024
+
+// compressibleTypes are the content types, other than text/*, compressed by the module.
+var compressibleTypes = map[string]struct{}{
+	"application/javascript": {},
+	"application/json":       {},
+	"application/wasm":       {},
+	"application/xml":        {},
+	"image/svg+xml":          {},
+}
+
+// IsCompressible returns true if a content of the given type is worth compressing.
+func IsCompressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+	_, ok := compressibleTypes[mediaType]
+	return ok
+}
+
+// EncodeContent returns the content as stored by the module along with its encoding. Compressible contents are
+// brotli-compressed, unless the item is already encoded or compression does not reduce the size. The compressor comes
+// from a dependency pinned in go.mod so that all the nodes store the same bytes. It panics if the compressor fails,
+// which cannot happen when writing to memory, rather than letting nodes store different bytes.
+func EncodeContent(meta *ItemMeta, content []byte) ([]byte, Encoding) {
+	if len(content) < MinCompressibleSize || meta.GetContentEncoding() != "" || !IsCompressible(meta.GetContentType()) {
+		return content, Encoding_ENCODING_IDENTITY
+	}
+
+	var buf bytes.Buffer
+	w := brotli.NewWriterLevel(&buf, brotli.DefaultCompression)
+	if _, err := w.Write(content); err != nil {
+		panic(fmt.Sprintf("failed to compress the content: %v", err))
+	}
+	if err := w.Close(); err != nil {
+		panic(fmt.Sprintf("failed to compress the content: %v", err))
+	}
+	if buf.Len() >= len(content) {
+		return content, Encoding_ENCODING_IDENTITY
+	}
+
+	return buf.Bytes(), Encoding_ENCODING_BROTLI
+}
+
+// DecodeContent returns the original content of a stored content.
+func DecodeContent(content []byte, encoding Encoding) ([]byte, error) {
+	switch encoding {
+	case Encoding_ENCODING_IDENTITY:
+		return content, nil
+	case Encoding_ENCODING_BROTLI:
+		return io.ReadAll(brotli.NewReader(bytes.NewReader(content)))
+	default:
+		return nil, fmt.Errorf("unsupported encoding: %s", encoding)
+	}
+}
+
+// HTTPContentEncoding returns the value of the Content-Encoding header of a content with the given encoding.
+func HTTPContentEncoding(encoding Encoding) string {
+	switch encoding {
+	case Encoding_ENCODING_BROTLI:
+		return "br"
+	default:
+		return ""
+	}
+}
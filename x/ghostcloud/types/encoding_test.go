@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCompressible(t *testing.T) {
+	tests := []struct {
+		contentType string
+		expected    bool
+	}{
+		{contentType: "text/html; charset=utf-8", expected: true},
+		{contentType: "text/css", expected: true},
+		{contentType: "application/json", expected: true},
+		{contentType: "image/svg+xml", expected: true},
+		{contentType: "application/wasm", expected: true},
+		{contentType: "image/png", expected: false},
+		{contentType: "font/woff2", expected: false},
+		{contentType: "application/octet-stream", expected: false},
+		{contentType: "", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.IsCompressible(tt.contentType))
+		})
+	}
+}
+
+func TestEncodeContent(t *testing.T) {
+	html := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+	zeros := bytes.Repeat([]byte{0x00}, types.MinCompressibleSize)
+
+	tests := []struct {
+		name     string
+		meta     *types.ItemMeta
+		content  []byte
+		encoding types.Encoding
+	}{
+		{
+			name:     "compressible",
+			meta:     &types.ItemMeta{Path: "index.html", ContentType: "text/html; charset=utf-8"},
+			content:  html,
+			encoding: types.Encoding_ENCODING_BROTLI,
+		},
+		{
+			name:     "too small",
+			meta:     &types.ItemMeta{Path: "index.html", ContentType: "text/html; charset=utf-8"},
+			content:  []byte("<h1>Hello</h1>"),
+			encoding: types.Encoding_ENCODING_IDENTITY,
+		},
+		{
+			name:     "not compressible",
+			meta:     &types.ItemMeta{Path: "blob.bin", ContentType: "application/octet-stream"},
+			content:  zeros,
+			encoding: types.Encoding_ENCODING_IDENTITY,
+		},
+		{
+			name:     "already encoded",
+			meta:     &types.ItemMeta{Path: "index.html", ContentType: "text/html; charset=utf-8", ContentEncoding: "br"},
+			content:  html,
+			encoding: types.Encoding_ENCODING_IDENTITY,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			encoded, encoding := types.EncodeContent(tt.meta, tt.content)
+			require.Equal(t, tt.encoding, encoding)
+			if encoding == types.Encoding_ENCODING_IDENTITY {
+				require.Equal(t, tt.content, encoded)
+			} else {
+				require.Less(t, len(encoded), len(tt.content))
+			}
+
+			// Encoding is deterministic
+			again, _ := types.EncodeContent(tt.meta, tt.content)
+			require.Equal(t, encoded, again)
+
+			decoded, err := types.DecodeContent(encoded, encoding)
+			require.NoError(t, err)
+			require.Equal(t, tt.content, decoded)
+		})
+	}
+}
+
+// TestEncodeContentGolden fails if a dependency bump changes the compressed bytes, which are part of the consensus
+// state.
+func TestEncodeContentGolden(t *testing.T) {
+	html := []byte(strings.Repeat("<p>Hello, World!</p>", 100))
+	meta := &types.ItemMeta{Path: "index.html", ContentType: "text/html; charset=utf-8"}
+
+	encoded, encoding := types.EncodeContent(meta, html)
+	require.Equal(t, types.Encoding_ENCODING_BROTLI, encoding)
+	require.Equal(t, "1bcf0700041c72a4cf036c7186b528c84463985c3f8abcfb3546632041e6afbb03", hex.EncodeToString(encoded))
+}
+
+func TestDecodeContentInvalid(t *testing.T) {
+	_, err := types.DecodeContent([]byte("not brotli"), types.Encoding_ENCODING_BROTLI)
+	require.Error(t, err)
+
+	_, err = types.DecodeContent([]byte("foo"), types.Encoding(42))
+	require.ErrorContains(t, err, "unsupported encoding")
+}
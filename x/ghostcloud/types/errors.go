@@ -1,4 +1,16 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
 // x/ghostcloud module sentinel errors
-var ()
+var (
+	ErrEmptyPath        = errorsmod.Register(ModuleName, 1100, PathShouldNotBeEmpty)
+	ErrAbsolutePath     = errorsmod.Register(ModuleName, 1101, "path should be relative")
+	ErrPathTraversal    = errorsmod.Register(ModuleName, 1102, "path should not contain `..` segments")
+	ErrPathBackslash    = errorsmod.Register(ModuleName, 1103, "path should not contain backslashes")
+	ErrEmptyPathSegment = errorsmod.Register(ModuleName, 1104, "path should not contain empty segments")
+	ErrPathNulByte      = errorsmod.Register(ModuleName, 1105, "path should not contain NUL bytes")
+	ErrDuplicatePath    = errorsmod.Register(ModuleName, 1106, "duplicate path")
+)
@@ -34,6 +34,9 @@ const (
 	Filter_LESS_THAN_OR_EQUAL    Filter_Operator = 5
 	Filter_CONTAINS              Filter_Operator = 6
 	Filter_NOT_CONTAINS          Filter_Operator = 7
+	Filter_PREFIX                Filter_Operator = 8
+	// REGEX matches the value as an RE2 regular expression.
+	Filter_REGEX Filter_Operator = 9
 )
 
 var Filter_Operator_name = map[int32]string{
@@ -45,6 +48,8 @@ var Filter_Operator_name = map[int32]string{
 	5: "LESS_THAN_OR_EQUAL",
 	6: "CONTAINS",
 	7: "NOT_CONTAINS",
+	8: "PREFIX",
+	9: "REGEX",
 }
 
 var Filter_Operator_value = map[string]int32{
@@ -56,6 +61,8 @@ var Filter_Operator_value = map[string]int32{
 	"LESS_THAN_OR_EQUAL":    5,
 	"CONTAINS":              6,
 	"NOT_CONTAINS":          7,
+	"PREFIX":                8,
+	"REGEX":                 9,
 }
 
 func (x Filter_Operator) String() string {
@@ -69,15 +76,24 @@ func (Filter_Operator) EnumDescriptor() ([]byte, []int) {
 type Filter_Field int32
 
 const (
-	Filter_CREATOR Filter_Field = 0
+	Filter_CREATOR     Filter_Field = 0
+	Filter_NAME        Filter_Field = 1
+	Filter_DOMAIN      Filter_Field = 2
+	Filter_DESCRIPTION Filter_Field = 3
 )
 
 var Filter_Field_name = map[int32]string{
 	0: "CREATOR",
+	1: "NAME",
+	2: "DOMAIN",
+	3: "DESCRIPTION",
 }
 
 var Filter_Field_value = map[string]int32{
-	"CREATOR": 0,
+	"CREATOR":     0,
+	"NAME":        1,
+	"DOMAIN":      2,
+	"DESCRIPTION": 3,
 }
 
 func (x Filter_Field) String() string {
@@ -148,10 +164,56 @@ func (m *Filter) GetValue() string {
 	return ""
 }
 
+// FilterGroup matches when all its filters match.
+type FilterGroup struct {
+	Filters []*Filter `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (m *FilterGroup) Reset()         { *m = FilterGroup{} }
+func (m *FilterGroup) String() string { return proto.CompactTextString(m) }
+func (*FilterGroup) ProtoMessage()    {}
+func (*FilterGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_06bc0776f42f2222, []int{1}
+}
+func (m *FilterGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FilterGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FilterGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FilterGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilterGroup.Merge(m, src)
+}
+func (m *FilterGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *FilterGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilterGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilterGroup proto.InternalMessageInfo
+
+func (m *FilterGroup) GetFilters() []*Filter {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ghostcloud.ghostcloud.Filter_Operator", Filter_Operator_name, Filter_Operator_value)
 	proto.RegisterEnum("ghostcloud.ghostcloud.Filter_Field", Filter_Field_name, Filter_Field_value)
 	proto.RegisterType((*Filter)(nil), "ghostcloud.ghostcloud.Filter")
+	proto.RegisterType((*FilterGroup)(nil), "ghostcloud.ghostcloud.FilterGroup")
 }
 
 func init() {
@@ -159,26 +221,31 @@ func init() {
 }
 
 var fileDescriptor_06bc0776f42f2222 = []byte{
-	// 303 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xcf, 0xc8, 0x2f,
-	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0xa6, 0x65, 0xe6, 0x94, 0xa4, 0x16, 0xe9,
-	0x26, 0x55, 0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0xe4, 0xf4, 0x10, 0x4c, 0xa5,
-	0x73, 0x4c, 0x5c, 0x6c, 0x6e, 0x60, 0xa5, 0x42, 0x96, 0x5c, 0xac, 0x69, 0x99, 0xa9, 0x39, 0x29,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xca, 0x7a, 0x58, 0x75, 0xe8, 0x41, 0x54, 0xeb, 0xb9,
-	0x81, 0x94, 0x06, 0x41, 0x74, 0x08, 0x39, 0x71, 0x71, 0xe4, 0x17, 0xa4, 0x16, 0x25, 0x96, 0xe4,
-	0x17, 0x49, 0x30, 0x81, 0x75, 0xab, 0xe1, 0xd7, 0xed, 0x0f, 0x55, 0x1d, 0x04, 0xd7, 0x27, 0x24,
-	0xc2, 0xc5, 0x5a, 0x96, 0x98, 0x53, 0x9a, 0x2a, 0xc1, 0xac, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe1,
-	0x28, 0xcd, 0x60, 0xe4, 0xe2, 0x80, 0x29, 0x16, 0xe2, 0xe4, 0x62, 0x75, 0x0d, 0x0c, 0x75, 0xf4,
-	0x11, 0x60, 0x10, 0xe2, 0xe5, 0xe2, 0xf4, 0xf3, 0x0f, 0x89, 0x87, 0x70, 0x19, 0x85, 0x04, 0xb8,
-	0x78, 0xdc, 0x83, 0x5c, 0x1d, 0x43, 0x5c, 0x83, 0xe2, 0x43, 0x3c, 0x1c, 0xfd, 0x04, 0x98, 0x40,
-	0x0a, 0x7c, 0x5c, 0x83, 0x83, 0x21, 0x5c, 0x66, 0x21, 0x49, 0x2e, 0x51, 0x64, 0x05, 0xf1, 0xfe,
-	0x41, 0x50, 0xbd, 0x2c, 0x42, 0x62, 0x5c, 0x42, 0x70, 0x95, 0x08, 0x71, 0x56, 0x21, 0x1e, 0x2e,
-	0x0e, 0x67, 0x7f, 0xbf, 0x10, 0x47, 0x4f, 0xbf, 0x60, 0x01, 0x36, 0x90, 0x0d, 0x20, 0x0b, 0xe1,
-	0x22, 0xec, 0x4a, 0x22, 0x5c, 0xac, 0xe0, 0x40, 0x10, 0xe2, 0xe6, 0x62, 0x77, 0x06, 0x99, 0xed,
-	0x1f, 0x24, 0xc0, 0xe0, 0x64, 0x7e, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0xb2, 0x48, 0xb1, 0x53, 0x81, 0x1c, 0x55, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x78,
-	0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfd, 0xbc, 0xca, 0xda, 0xd0, 0x01, 0x00, 0x00,
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcf, 0xce, 0xd2, 0x40,
+	0x10, 0xc0, 0xbb, 0x5f, 0xbf, 0x96, 0x76, 0x8a, 0xba, 0xd9, 0x88, 0xa9, 0x07, 0x1a, 0x52, 0xa3,
+	0xe1, 0x62, 0x4d, 0xf0, 0x40, 0x8c, 0xa7, 0x02, 0x5b, 0x6c, 0x02, 0x2d, 0x6e, 0x6b, 0x42, 0xbc,
+	0x10, 0x90, 0xa2, 0x24, 0x4d, 0xda, 0x94, 0x62, 0xe4, 0x1d, 0x3c, 0xf8, 0x26, 0xbe, 0x86, 0x47,
+	0x8e, 0x1e, 0x0d, 0xbc, 0x88, 0xd9, 0x96, 0x3f, 0x3d, 0x7c, 0xe1, 0x36, 0x33, 0xfb, 0xfb, 0x65,
+	0x26, 0xb3, 0x03, 0x2f, 0xbf, 0x7e, 0x4b, 0x36, 0xf9, 0x97, 0x38, 0xd9, 0x2e, 0xdf, 0x54, 0xc2,
+	0xd5, 0x3a, 0xce, 0xa3, 0xec, 0xf5, 0x62, 0x67, 0xa5, 0x59, 0x92, 0x27, 0xa4, 0x71, 0x7d, 0xb3,
+	0xae, 0xa1, 0xf9, 0x53, 0x04, 0xd9, 0x29, 0x50, 0xf2, 0x0e, 0xa4, 0xd5, 0x3a, 0x8a, 0x97, 0x3a,
+	0x6a, 0xa1, 0xf6, 0xe3, 0xce, 0x0b, 0xeb, 0x41, 0xc3, 0x2a, 0x69, 0xcb, 0xe1, 0x28, 0x2b, 0x0d,
+	0xd2, 0x03, 0x25, 0x49, 0xa3, 0x6c, 0x9e, 0x27, 0x99, 0x7e, 0x57, 0xd8, 0xaf, 0x6e, 0xdb, 0xfe,
+	0x89, 0x66, 0x17, 0x8f, 0x3c, 0x05, 0xe9, 0xfb, 0x3c, 0xde, 0x46, 0xba, 0xd8, 0x42, 0x6d, 0x95,
+	0x95, 0x89, 0xf9, 0x1b, 0x81, 0x72, 0x86, 0x89, 0x0a, 0x12, 0xfd, 0xf8, 0xc9, 0x1e, 0x61, 0x81,
+	0x3c, 0x02, 0xd5, 0xf3, 0xc3, 0x59, 0x99, 0x22, 0x82, 0xa1, 0x3e, 0x64, 0xd4, 0x0e, 0x29, 0x9b,
+	0x85, 0x1f, 0x6c, 0x0f, 0xdf, 0x71, 0x60, 0x44, 0x83, 0xa0, 0x4c, 0x45, 0xf2, 0x1c, 0x1a, 0x55,
+	0x60, 0xe6, 0xb3, 0x93, 0x7b, 0x4f, 0x9e, 0x01, 0xb9, 0x90, 0xd7, 0xba, 0x44, 0xea, 0xa0, 0xf4,
+	0x7d, 0x2f, 0xb4, 0x5d, 0x2f, 0xc0, 0x32, 0xef, 0xc0, 0x1b, 0x5e, 0x2a, 0x35, 0x02, 0x20, 0x4f,
+	0x18, 0x75, 0xdc, 0x29, 0x56, 0xf8, 0x64, 0x8c, 0x0e, 0xe9, 0x14, 0xab, 0xe6, 0x7b, 0x90, 0x8a,
+	0xdd, 0x10, 0x0d, 0x6a, 0x7d, 0xde, 0xd2, 0x67, 0x58, 0x20, 0x0a, 0xdc, 0x7b, 0xf6, 0x98, 0x62,
+	0xc4, 0xb5, 0x81, 0x3f, 0xb6, 0x5d, 0x3e, 0xe4, 0x13, 0xd0, 0x06, 0x34, 0xe8, 0x33, 0x77, 0x12,
+	0xba, 0xbe, 0x87, 0x45, 0xd3, 0x01, 0xad, 0xdc, 0xd0, 0x30, 0x4b, 0xb6, 0x29, 0xe9, 0x42, 0xad,
+	0xfc, 0xc7, 0x8d, 0x8e, 0x5a, 0x62, 0x5b, 0xeb, 0x34, 0x6f, 0xae, 0x95, 0x9d, 0xe9, 0x5e, 0xf7,
+	0xcf, 0xc1, 0x40, 0xfb, 0x83, 0x81, 0xfe, 0x1d, 0x0c, 0xf4, 0xeb, 0x68, 0x08, 0xfb, 0xa3, 0x21,
+	0xfc, 0x3d, 0x1a, 0xc2, 0xe7, 0x66, 0xe5, 0x46, 0x7e, 0x54, 0x0f, 0x26, 0xdf, 0xa5, 0xd1, 0x66,
+	0x21, 0x17, 0xd7, 0xf2, 0xf6, 0xff, 0x00, 0x12, 0xab, 0x96, 0x9f, 0x56, 0x02, 0x00, 0x00,
 }
 
 func (m *Filter) Marshal() (dAtA []byte, err error) {
@@ -221,6 +288,43 @@ func (m *Filter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FilterGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FilterGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FilterGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for iNdEx := len(m.Filters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Filters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFilterBy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFilterBy(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFilterBy(v)
 	base := offset
@@ -251,6 +355,21 @@ func (m *Filter) Size() (n int) {
 	return n
 }
 
+func (m *FilterGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Filters) > 0 {
+		for _, e := range m.Filters {
+			l = e.Size()
+			n += 1 + l + sovFilterBy(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovFilterBy(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -377,6 +496,90 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FilterGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFilterBy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FilterGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FilterGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Filters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFilterBy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFilterBy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFilterBy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Filters = append(m.Filters, &Filter{})
+			if err := m.Filters[len(m.Filters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFilterBy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFilterBy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipFilterBy(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,8 +14,12 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params:      DefaultParams(),
-		Deployments: []*Deployment{},
+		Params:        DefaultParams(),
+		Deployments:   []*Deployment{},
+		DomainClaims:  []*DomainClaim{},
+		Transfers:     []*DeploymentTransfer{},
+		Collaborators: []*Collaborator{},
+		Revisions:     []*DeploymentRevision{},
 	}
 }
 
@@ -24,12 +29,19 @@ func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 	deploymentMetaIndexMap := make(map[string]struct{})
 	deploymentFileMetaIndexMap := make(map[string]struct{})
+	domainIndexMap := make(map[string]struct{})
+	idIndexMap := make(map[uint64]struct{})
+	// contentSizes maps the hash of the contents, of files and revisions, to their size
+	contentSizes := make(map[string]uint64)
 
 	for _, elem := range gs.Deployments {
 		addr, err := sdk.AccAddressFromBech32(elem.Meta.Creator)
 		if err != nil {
 			return err
 		}
+		if err := ValidateNameKey(elem.Meta.Name); err != nil {
+			return err
+		}
 
 		// Check for duplicate meta
 		index := string(DeploymentKey(addr, elem.Meta.Name))
@@ -38,15 +50,193 @@ func (gs GenesisState) Validate() error {
 		}
 		deploymentMetaIndexMap[index] = struct{}{}
 
-		// Check for duplicate files
+		// Check for duplicate identifiers, deployments without identifier are assigned one at genesis
+		if elem.Meta.Id != 0 {
+			if _, ok := idIndexMap[elem.Meta.Id]; ok {
+				return fmt.Errorf("duplicated id for deployment: %d", elem.Meta.Id)
+			}
+			idIndexMap[elem.Meta.Id] = struct{}{}
+		}
+
+		// Check for duplicate domains
+		if elem.Meta.DomainVerified && elem.Meta.Domain == "" {
+			return fmt.Errorf("verified empty domain for deployment: %s", elem.Meta.Name)
+		}
+		if elem.Meta.Domain != "" {
+			domain := NormalizeDomain(elem.Meta.Domain)
+			if _, ok := domainIndexMap[domain]; ok {
+				return fmt.Errorf("duplicated domain for deployment: %s", elem.Meta.Domain)
+			}
+			domainIndexMap[domain] = struct{}{}
+		}
+
+		// Check for duplicate files and non-canonical paths
 		for _, file := range elem.Dataset.Items {
+			if path, err := CanonicalPath(file.Meta.Path); err != nil {
+				return err
+			} else if path != file.Meta.Path {
+				return fmt.Errorf("non-canonical path for deployment item: %s", file.Meta.Path)
+			}
 			index = string(DeploymentItemKey(addr, elem.Meta.Name, file.Meta.Path))
 			if _, ok := deploymentFileMetaIndexMap[index]; ok {
 				return fmt.Errorf("duplicated index for deployment")
 			}
 			deploymentFileMetaIndexMap[index] = struct{}{}
+
+			// Check the content hash, if any
+			if len(file.Meta.Hash) > 0 && !bytes.Equal(file.Meta.Hash, ContentHash(file.GetContent().GetContent())) {
+				return fmt.Errorf("invalid content hash for deployment item: %s", file.Meta.Path)
+			}
+			// Check the content size, if any
+			if file.Meta.Size_ > 0 && file.Meta.Size_ != uint64(len(file.GetContent().GetContent())) {
+				return fmt.Errorf("invalid content size for deployment item: %s", file.Meta.Path)
+			}
+			if err := ValidateItemMeta(file.Meta); err != nil {
+				return err
+			}
+			contentSizes[string(ContentHash(file.GetContent().GetContent()))] = uint64(len(file.GetContent().GetContent()))
+		}
+	}
+
+	domainClaimIndexMap := make(map[string]struct{})
+	for _, claim := range gs.DomainClaims {
+		addr, err := sdk.AccAddressFromBech32(claim.Creator)
+		if err != nil {
+			return err
 		}
+		if err := ValidateNameKey(claim.Name); err != nil {
+			return err
+		}
+
+		// Check for duplicate claims and claims of unknown deployments
+		index := string(DeploymentKey(addr, claim.Name))
+		if _, ok := domainClaimIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for domain claim")
+		}
+		if _, ok := deploymentMetaIndexMap[index]; !ok {
+			return fmt.Errorf("domain claim for unknown deployment: %s", claim.Name)
+		}
+		domainClaimIndexMap[index] = struct{}{}
+	}
+
+	transferIndexMap := make(map[string]struct{})
+	for _, transfer := range gs.Transfers {
+		addr, err := sdk.AccAddressFromBech32(transfer.Creator)
+		if err != nil {
+			return err
+		}
+		if err := ValidateNameKey(transfer.Name); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(transfer.Recipient); err != nil {
+			return err
+		}
+
+		// Check for duplicate transfers and transfers of unknown deployments
+		index := string(DeploymentKey(addr, transfer.Name))
+		if _, ok := transferIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for transfer")
+		}
+		if _, ok := deploymentMetaIndexMap[index]; !ok {
+			return fmt.Errorf("transfer of unknown deployment: %s", transfer.Name)
+		}
+		transferIndexMap[index] = struct{}{}
+	}
+
+	collaboratorIndexMap := make(map[string]struct{})
+	for _, collaborator := range gs.Collaborators {
+		addr, err := sdk.AccAddressFromBech32(collaborator.Creator)
+		if err != nil {
+			return err
+		}
+		if err := ValidateNameKey(collaborator.Name); err != nil {
+			return err
+		}
+		collaboratorAddr, err := sdk.AccAddressFromBech32(collaborator.Address)
+		if err != nil {
+			return err
+		}
+		if collaboratorAddr.Equals(addr) {
+			return fmt.Errorf(CollaboratorIsCreator)
+		}
+		if _, ok := CollaboratorRole_name[int32(collaborator.Role)]; !ok || collaborator.Role == CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED {
+			return fmt.Errorf(InvalidCollaboratorRole, collaborator.Role)
+		}
+
+		// Check for duplicate collaborators and collaborators of unknown deployments
+		if _, ok := deploymentMetaIndexMap[string(DeploymentKey(addr, collaborator.Name))]; !ok {
+			return fmt.Errorf("collaborator of unknown deployment: %s", collaborator.Name)
+		}
+		index := string(CollaboratorKey(addr, collaborator.Name, collaboratorAddr))
+		if _, ok := collaboratorIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for collaborator")
+		}
+		collaboratorIndexMap[index] = struct{}{}
+	}
+
+	if err := gs.validateRevisions(deploymentMetaIndexMap, contentSizes); err != nil {
+		return err
 	}
 
 	return gs.Params.Validate()
 }
+
+// validateRevisions validates the revisions of the deployments. The contents of their files must be either the
+// contents of the files of a deployment or revision contents.
+func (gs GenesisState) validateRevisions(deploymentMetaIndexMap map[string]struct{}, contentSizes map[string]uint64) error {
+	for _, content := range gs.RevisionContents {
+		hash := string(ContentHash(content.GetContent()))
+		if _, ok := contentSizes[hash]; ok {
+			return fmt.Errorf("duplicated revision content")
+		}
+		contentSizes[hash] = uint64(len(content.GetContent()))
+	}
+
+	revisionIndexMap := make(map[string]struct{})
+	revisionCounts := make(map[string]uint64)
+	for _, elem := range gs.Revisions {
+		addr, err := sdk.AccAddressFromBech32(elem.Creator)
+		if err != nil {
+			return err
+		}
+		if err := ValidateNameKey(elem.Name); err != nil {
+			return err
+		}
+		revision := elem.GetRevision()
+		if revision == nil || revision.Number == 0 {
+			return fmt.Errorf("invalid revision of deployment: %s", elem.Name)
+		}
+
+		// Check for duplicate revisions, revisions of unknown deployments and retained revisions beyond the limit
+		deploymentIndex := string(DeploymentKey(addr, elem.Name))
+		if _, ok := deploymentMetaIndexMap[deploymentIndex]; !ok {
+			return fmt.Errorf("revision of unknown deployment: %s", elem.Name)
+		}
+		index := string(RevisionKey(addr, elem.Name, revision.Number))
+		if _, ok := revisionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for revision")
+		}
+		revisionIndexMap[index] = struct{}{}
+		revisionCounts[deploymentIndex]++
+		if revisionCounts[deploymentIndex] > gs.Params.MaxRevisions {
+			return fmt.Errorf("too many revisions for deployment: %s", elem.Name)
+		}
+
+		for _, item := range revision.Items {
+			if path, err := CanonicalPath(item.Path); err != nil {
+				return err
+			} else if path != item.Path {
+				return fmt.Errorf("non-canonical path for revision item: %s", item.Path)
+			}
+			size, ok := contentSizes[string(item.Hash)]
+			if !ok {
+				return fmt.Errorf("unknown content for revision item: %s", item.Path)
+			}
+			if size != item.Size_ {
+				return fmt.Errorf("invalid content size for revision item: %s", item.Path)
+			}
+		}
+	}
+
+	return nil
+}
@@ -76,17 +76,84 @@ func (m *Deployment) GetDataset() *Dataset {
 	return nil
 }
 
+// DeploymentRevision is a revision of the deployment with the given creator and name.
+type DeploymentRevision struct {
+	Creator  string    `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Name     string    `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Revision *Revision `protobuf:"bytes,3,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *DeploymentRevision) Reset()         { *m = DeploymentRevision{} }
+func (m *DeploymentRevision) String() string { return proto.CompactTextString(m) }
+func (*DeploymentRevision) ProtoMessage()    {}
+func (*DeploymentRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0815e518ef9dd98, []int{1}
+}
+func (m *DeploymentRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentRevision.Merge(m, src)
+}
+func (m *DeploymentRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentRevision proto.InternalMessageInfo
+
+func (m *DeploymentRevision) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DeploymentRevision) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeploymentRevision) GetRevision() *Revision {
+	if m != nil {
+		return m.Revision
+	}
+	return nil
+}
+
 // GenesisState defines the ghostcloud module's genesis state.
 type GenesisState struct {
-	Params      Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Deployments []*Deployment `protobuf:"bytes,2,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	Params       Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Deployments  []*Deployment         `protobuf:"bytes,2,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	DomainClaims []*DomainClaim        `protobuf:"bytes,3,rep,name=domain_claims,json=domainClaims,proto3" json:"domain_claims,omitempty"`
+	Revisions    []*DeploymentRevision `protobuf:"bytes,4,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	// revision_contents are the contents only referenced by revisions, i.e., not by the files of a deployment.
+	RevisionContents []*ItemContent        `protobuf:"bytes,5,rep,name=revision_contents,json=revisionContents,proto3" json:"revision_contents,omitempty"`
+	Transfers        []*DeploymentTransfer `protobuf:"bytes,6,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Collaborators    []*Collaborator       `protobuf:"bytes,7,rep,name=collaborators,proto3" json:"collaborators,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0815e518ef9dd98, []int{1}
+	return fileDescriptor_e0815e518ef9dd98, []int{2}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -129,8 +196,44 @@ func (m *GenesisState) GetDeployments() []*Deployment {
 	return nil
 }
 
+func (m *GenesisState) GetDomainClaims() []*DomainClaim {
+	if m != nil {
+		return m.DomainClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevisions() []*DeploymentRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevisionContents() []*ItemContent {
+	if m != nil {
+		return m.RevisionContents
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransfers() []*DeploymentTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollaborators() []*Collaborator {
+	if m != nil {
+		return m.Collaborators
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Deployment)(nil), "ghostcloud.ghostcloud.Deployment")
+	proto.RegisterType((*DeploymentRevision)(nil), "ghostcloud.ghostcloud.DeploymentRevision")
 	proto.RegisterType((*GenesisState)(nil), "ghostcloud.ghostcloud.GenesisState")
 }
 
@@ -139,24 +242,36 @@ func init() {
 }
 
 var fileDescriptor_e0815e518ef9dd98 = []byte{
-	// 269 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4e, 0xcf, 0xc8, 0x2f,
-	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x66, 0xa6, 0xe6, 0xa5, 0x16, 0x67, 0x16, 0xeb,
-	0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x64, 0xf4, 0x10, 0x4c, 0x29, 0x91, 0xf4, 0xfc,
-	0xf4, 0x7c, 0xb0, 0x0a, 0x7d, 0x10, 0x0b, 0xa2, 0x58, 0x0a, 0x87, 0x89, 0x29, 0x89, 0x25, 0x89,
-	0xc5, 0xa9, 0x25, 0x50, 0x45, 0x0a, 0xd8, 0x15, 0xe5, 0xa6, 0x96, 0x24, 0x42, 0x55, 0x28, 0x61,
-	0x57, 0x51, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x75, 0x97, 0x52, 0x39, 0x17, 0x97, 0x4b, 0x6a, 0x41,
-	0x4e, 0x7e, 0x65, 0x6e, 0x6a, 0x5e, 0x89, 0x90, 0x3e, 0x17, 0x0b, 0x48, 0xbf, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0xb4, 0x1e, 0x56, 0x47, 0xeb, 0xf9, 0xa6, 0x96, 0x24, 0x06, 0x81, 0x15,
-	0x0a, 0x59, 0x70, 0xb1, 0x43, 0x5d, 0x25, 0xc1, 0x04, 0xd6, 0x23, 0x87, 0x43, 0x8f, 0x0b, 0x44,
-	0x55, 0x10, 0x4c, 0xb9, 0xd2, 0x04, 0x46, 0x2e, 0x1e, 0x77, 0x48, 0x10, 0x05, 0x97, 0x24, 0x96,
-	0xa4, 0x0a, 0x59, 0x73, 0xb1, 0x41, 0x5c, 0x06, 0xb5, 0x5d, 0x16, 0x87, 0x49, 0x01, 0x60, 0x45,
-	0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x08, 0x39, 0x73, 0x71, 0xa7, 0xc0, 0xbd,
-	0x51, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0x88, 0xcb, 0x2d, 0x70, 0x95, 0x41, 0xc8,
-	0xba, 0x9c, 0xcc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6,
-	0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x16, 0x29,
-	0xf8, 0x2a, 0x90, 0xc3, 0xb2, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x96, 0xc6, 0x80,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x94, 0xde, 0xa4, 0x0a, 0x02, 0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3f, 0x8f, 0xd3, 0x30,
+	0x18, 0xc6, 0x93, 0x6b, 0x68, 0xa9, 0x7b, 0x27, 0x81, 0x05, 0x92, 0x55, 0x74, 0xb9, 0x92, 0x63,
+	0x28, 0x4b, 0x2a, 0x1d, 0x03, 0x48, 0x6c, 0x97, 0x4a, 0x55, 0x07, 0x04, 0x32, 0x4c, 0x2c, 0x95,
+	0x9b, 0x98, 0x12, 0x29, 0x89, 0xa3, 0xd8, 0xfc, 0xe9, 0xc4, 0xc8, 0xca, 0xc7, 0xea, 0xd8, 0x91,
+	0x09, 0xa1, 0xf6, 0x8b, 0xa0, 0x38, 0x76, 0x93, 0x4a, 0x71, 0xb9, 0xcd, 0x4e, 0x7e, 0xcf, 0xf3,
+	0xbe, 0xcf, 0x2b, 0xbf, 0xe0, 0x7a, 0xf5, 0x99, 0x71, 0x11, 0x26, 0xec, 0x4b, 0x34, 0x69, 0x1e,
+	0x69, 0x46, 0x79, 0xcc, 0xfd, 0xbc, 0x60, 0x82, 0xc1, 0xc7, 0xf5, 0x1f, 0xbf, 0x3e, 0x0e, 0x1f,
+	0xad, 0xd8, 0x8a, 0x49, 0x62, 0x52, 0x9e, 0x2a, 0x78, 0x38, 0x6e, 0x77, 0x0c, 0x59, 0x92, 0x90,
+	0x25, 0x2b, 0x88, 0x60, 0x85, 0x22, 0x0d, 0xb5, 0x23, 0x22, 0x08, 0xa7, 0x42, 0x41, 0x9e, 0x01,
+	0x62, 0x29, 0x89, 0x33, 0xc5, 0x8c, 0xda, 0x99, 0x94, 0x0a, 0x72, 0xda, 0x25, 0x27, 0x05, 0x49,
+	0x55, 0xca, 0xe1, 0xb3, 0x76, 0xa6, 0xa0, 0x5f, 0x63, 0x1e, 0xb3, 0xec, 0x34, 0x25, 0x0a, 0x92,
+	0xf1, 0x4f, 0x54, 0x45, 0xf3, 0xbe, 0x01, 0x30, 0xa5, 0x79, 0xc2, 0xd6, 0x29, 0xcd, 0x04, 0x9c,
+	0x00, 0xa7, 0xec, 0x05, 0xd9, 0x23, 0x7b, 0x3c, 0xb8, 0x79, 0xe2, 0xb7, 0x8e, 0xd3, 0x7f, 0x43,
+	0x05, 0xc1, 0x12, 0x84, 0xaf, 0x40, 0x4f, 0x4d, 0x01, 0x9d, 0x49, 0x8d, 0x6b, 0xd0, 0x4c, 0x2b,
+	0x0a, 0x6b, 0xdc, 0xfb, 0x01, 0x60, 0x5d, 0x18, 0xab, 0xd6, 0x21, 0x02, 0xbd, 0xb0, 0xa0, 0xe5,
+	0xe8, 0x65, 0x0f, 0x7d, 0xac, 0xaf, 0x10, 0x02, 0x27, 0x23, 0x29, 0x95, 0x65, 0xfa, 0x58, 0x9e,
+	0xe1, 0x6b, 0x70, 0x5f, 0x87, 0x46, 0x1d, 0x59, 0xfe, 0xca, 0x50, 0x5e, 0x17, 0xc0, 0x07, 0x81,
+	0xf7, 0xd3, 0x01, 0xe7, 0xb3, 0xea, 0xf5, 0xbc, 0x17, 0x44, 0x94, 0x6e, 0xdd, 0x6a, 0xcc, 0x2a,
+	0xfe, 0xa5, 0xc1, 0xeb, 0x9d, 0x84, 0x6e, 0x9d, 0xcd, 0x9f, 0x2b, 0x0b, 0x2b, 0x09, 0x0c, 0xc0,
+	0x20, 0x3a, 0xc4, 0xe1, 0xe8, 0x6c, 0xd4, 0x19, 0x0f, 0x6e, 0x9e, 0x9a, 0x86, 0x51, 0x07, 0x6f,
+	0xaa, 0xe0, 0x0c, 0x5c, 0x54, 0xcf, 0x65, 0x11, 0x26, 0x24, 0x4e, 0x39, 0xea, 0x48, 0x1b, 0xcf,
+	0x64, 0x23, 0xd9, 0xa0, 0x44, 0xf1, 0x79, 0x54, 0x5f, 0x4a, 0xa3, 0xbe, 0xce, 0xc9, 0x91, 0x23,
+	0x4d, 0x9e, 0xff, 0xbf, 0x17, 0x3d, 0xa3, 0x5a, 0x0b, 0xdf, 0x82, 0x87, 0xfa, 0xb2, 0x08, 0x59,
+	0x26, 0x64, 0xb8, 0x7b, 0x27, 0xbb, 0x9a, 0x0b, 0x9a, 0x06, 0x15, 0x8a, 0x1f, 0x68, 0xb1, 0xfa,
+	0x20, 0x3b, 0xd3, 0x2f, 0x90, 0xa3, 0xee, 0x1d, 0x3b, 0xfb, 0xa0, 0x14, 0xb8, 0xd6, 0xc2, 0x39,
+	0xb8, 0x68, 0x6e, 0x2a, 0x47, 0x3d, 0x69, 0x76, 0x6d, 0x30, 0x0b, 0x1a, 0x2c, 0x3e, 0x56, 0xde,
+	0xbe, 0xdc, 0xec, 0x5c, 0x7b, 0xbb, 0x73, 0xed, 0xbf, 0x3b, 0xd7, 0xfe, 0xb5, 0x77, 0xad, 0xed,
+	0xde, 0xb5, 0x7e, 0xef, 0x5d, 0xeb, 0xe3, 0x65, 0x63, 0x71, 0xbe, 0x1f, 0x6d, 0xd1, 0x3a, 0xa7,
+	0x7c, 0xd9, 0x95, 0x3b, 0xf4, 0xe2, 0xdf, 0x00, 0x7c, 0x2e, 0x04, 0x48, 0x9c, 0x04, 0x00, 0x00,
 }
 
 func (m *Deployment) Marshal() (dAtA []byte, err error) {
@@ -206,6 +321,55 @@ func (m *Deployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeploymentRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != nil {
+		{
+			size, err := m.Revision.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -226,6 +390,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Collaborators) > 0 {
+		for iNdEx := len(m.Collaborators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Collaborators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RevisionContents) > 0 {
+		for iNdEx := len(m.RevisionContents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevisionContents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for iNdEx := len(m.Revisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DomainClaims) > 0 {
+		for iNdEx := len(m.DomainClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Deployments) > 0 {
 		for iNdEx := len(m.Deployments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -281,6 +515,27 @@ func (m *Deployment) Size() (n int) {
 	return n
 }
 
+func (m *DeploymentRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Revision != nil {
+		l = m.Revision.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -295,6 +550,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DomainClaims) > 0 {
+		for _, e := range m.DomainClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Revisions) > 0 {
+		for _, e := range m.Revisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevisionContents) > 0 {
+		for _, e := range m.RevisionContents {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Collaborators) > 0 {
+		for _, e := range m.Collaborators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -426,6 +711,156 @@ func (m *Deployment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DeploymentRevision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentRevision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentRevision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Revision == nil {
+				m.Revision = &Revision{}
+			}
+			if err := m.Revision.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -522,6 +957,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainClaims = append(m.DomainClaims, &DomainClaim{})
+			if err := m.DomainClaims[len(m.DomainClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, &DeploymentRevision{})
+			if err := m.Revisions[len(m.Revisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionContents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevisionContents = append(m.RevisionContents, &ItemContent{})
+			if err := m.RevisionContents[len(m.RevisionContents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, &DeploymentTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collaborators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Collaborators = append(m.Collaborators, &Collaborator{})
+			if err := m.Collaborators[len(m.Collaborators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"ghostcloud/testutil/keeper"
@@ -12,6 +13,32 @@ import (
 
 func TestGenesisState_Validate(t *testing.T) {
 	deployment := sample.CreateDeployment(0, keeper.DATASET_SIZE)
+	sameDomain := sample.CreateDeployment(1, keeper.DATASET_SIZE)
+	sameDomain.Meta.Domain = deployment.Meta.Domain
+	verifiedWithoutDomain := sample.CreateDeployment(2, keeper.DATASET_SIZE)
+	verifiedWithoutDomain.Meta.Domain = ""
+	verifiedWithoutDomain.Meta.DomainVerified = true
+	invalidHash := sample.CreateDeployment(2, keeper.DATASET_SIZE)
+	invalidHash.Dataset.Items[0].Meta.Hash = types.ContentHash([]byte("invalid"))
+	invalidSize := sample.CreateDeployment(5, keeper.DATASET_SIZE)
+	invalidSize.Dataset.Items[0].Meta.Size_ = uint64(len(invalidSize.Dataset.Items[0].Content.Content)) + 1
+	invalidContentType := sample.CreateDeployment(6, keeper.DATASET_SIZE)
+	invalidContentType.Dataset.Items[0].Meta.ContentType = "text/"
+	invalidPath := sample.CreateDeployment(7, keeper.DATASET_SIZE)
+	invalidPath.Dataset.Items[0].Meta.Path = "../" + invalidPath.Dataset.Items[0].Meta.Path
+	nonCanonicalPath := sample.CreateDeployment(8, keeper.DATASET_SIZE)
+	nonCanonicalPath.Dataset.Items[0].Meta.Path = "./" + nonCanonicalPath.Dataset.Items[0].Meta.Path
+	withID := sample.CreateDeployment(3, keeper.DATASET_SIZE)
+	withID.Meta.Id = 7
+	sameID := sample.CreateDeployment(4, keeper.DATASET_SIZE)
+	sameID.Meta.Id = 7
+	transfer := &types.DeploymentTransfer{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Recipient: sample.AccAddress()}
+	collaborator := &types.Collaborator{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR}
+	claim := &types.DomainClaim{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Domain: "example.com", Token: "token"}
+	// Names longer than MaxNameSizeLimit do not fit in the length-prefixed store keys
+	longName := strings.Repeat("a", int(types.MaxNameSizeLimit)+1)
+	longNameDeployment := sample.CreateDeployment(9, keeper.DATASET_SIZE)
+	longNameDeployment.Meta.Name = longName
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -40,6 +67,228 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicate domain",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment, sameDomain},
+			},
+			valid: false,
+		},
+		{
+			desc: "verified empty domain",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{verifiedWithoutDomain},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid content hash",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{invalidHash},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid content size",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{invalidSize},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid path",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{invalidPath},
+			},
+			valid: false,
+		},
+		{
+			desc: "non-canonical path",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{nonCanonicalPath},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid content type",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{invalidContentType},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid domain claim",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Deployments:  []*types.Deployment{deployment},
+				DomainClaims: []*types.DomainClaim{claim},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate domain claim",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Deployments:  []*types.Deployment{deployment},
+				DomainClaims: []*types.DomainClaim{claim, claim},
+			},
+			valid: false,
+		},
+		{
+			desc: "domain claim of unknown deployment",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				DomainClaims: []*types.DomainClaim{claim},
+			},
+			valid: false,
+		},
+		{
+			desc: "domain claim name too long",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Deployments:  []*types.Deployment{deployment},
+				DomainClaims: []*types.DomainClaim{{Creator: deployment.Meta.Creator, Name: longName, Domain: "example.com", Token: "token"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "deployment name too long",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{longNameDeployment},
+			},
+			valid: false,
+		},
+		{
+			desc: "revision name too long",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Revisions: []*types.DeploymentRevision{{
+					Creator:  deployment.Meta.Creator,
+					Name:     longName,
+					Revision: &types.Revision{Number: 1},
+				}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate id",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{withID, sameID},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid transfer",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Transfers:   []*types.DeploymentTransfer{transfer},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate transfer",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Transfers:   []*types.DeploymentTransfer{transfer, transfer},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer of unknown deployment",
+			genState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				Transfers: []*types.DeploymentTransfer{transfer},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid transfer recipient",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Transfers:   []*types.DeploymentTransfer{{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Recipient: "invalid"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "transfer name too long",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Transfers:   []*types.DeploymentTransfer{{Creator: deployment.Meta.Creator, Name: longName, Recipient: sample.AccAddress()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid collaborator",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Deployments:   []*types.Deployment{deployment},
+				Collaborators: []*types.Collaborator{collaborator},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate collaborator",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Deployments:   []*types.Deployment{deployment},
+				Collaborators: []*types.Collaborator{collaborator, collaborator},
+			},
+			valid: false,
+		},
+		{
+			desc: "collaborator of unknown deployment",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Collaborators: []*types.Collaborator{collaborator},
+			},
+			valid: false,
+		},
+		{
+			desc: "creator as collaborator",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Deployments:   []*types.Deployment{deployment},
+				Collaborators: []*types.Collaborator{{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Address: deployment.Meta.Creator, Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR}},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified collaborator role",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				Deployments:   []*types.Deployment{deployment},
+				Collaborators: []*types.Collaborator{{Creator: deployment.Meta.Creator, Name: deployment.Meta.Name, Address: sample.AccAddress()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "collaborator name too long",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				Deployments: []*types.Deployment{deployment},
+				Collaborators: []*types.Collaborator{{
+					Creator: deployment.Meta.Creator,
+					Name:    longName,
+					Address: sample.AccAddress(),
+					Role:    types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR,
+				}},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxHeaderLength is the maximum length of the content type and HTTP headers of an item.
+const MaxHeaderLength = 256
+
+// contentTypes maps the file extensions commonly found in websites to their content type. The table is part of the
+// module so that the detected content types do not depend on the MIME database of the node.
+var contentTypes = map[string]string{
+	".avif":  "image/avif",
+	".css":   "text/css; charset=utf-8",
+	".csv":   "text/csv; charset=utf-8",
+	".gif":   "image/gif",
+	".htm":   "text/html; charset=utf-8",
+	".html":  "text/html; charset=utf-8",
+	".ico":   "image/vnd.microsoft.icon",
+	".jpeg":  "image/jpeg",
+	".jpg":   "image/jpeg",
+	".js":    "text/javascript; charset=utf-8",
+	".json":  "application/json",
+	".map":   "application/json",
+	".md":    "text/markdown; charset=utf-8",
+	".mjs":   "text/javascript; charset=utf-8",
+	".mp3":   "audio/mpeg",
+	".mp4":   "video/mp4",
+	".otf":   "font/otf",
+	".pdf":   "application/pdf",
+	".png":   "image/png",
+	".svg":   "image/svg+xml",
+	".ttf":   "font/ttf",
+	".txt":   "text/plain; charset=utf-8",
+	".wasm":  "application/wasm",
+	".webm":  "video/webm",
+	".webp":  "image/webp",
+	".woff":  "font/woff",
+	".woff2": "font/woff2",
+	".xml":   "text/xml; charset=utf-8",
+}
+
+// contentSignatures maps the leading bytes of common file formats to their content type. Unlike
+// http.DetectContentType, whose results change with the Go version, the table is part of the module so that all the
+// nodes store the same content type.
+var contentSignatures = []struct {
+	prefix      []byte
+	contentType string
+}{
+	{prefix: []byte("\x89PNG\r\n\x1a\n"), contentType: "image/png"},
+	{prefix: []byte("\xff\xd8\xff"), contentType: "image/jpeg"},
+	{prefix: []byte("GIF87a"), contentType: "image/gif"},
+	{prefix: []byte("GIF89a"), contentType: "image/gif"},
+	{prefix: []byte("%PDF-"), contentType: "application/pdf"},
+	{prefix: []byte("\x00asm"), contentType: "application/wasm"},
+	{prefix: []byte("wOFF"), contentType: "font/woff"},
+	{prefix: []byte("wOF2"), contentType: "font/woff2"},
+	{prefix: []byte("ID3"), contentType: "audio/mpeg"},
+	{prefix: []byte("\x1a\x45\xdf\xa3"), contentType: "video/webm"},
+	{prefix: []byte("PK\x03\x04"), contentType: "application/zip"},
+	{prefix: []byte("\x1f\x8b\x08"), contentType: "application/x-gzip"},
+}
+
+// htmlSignatures are the lowercase leading tags of HTML documents.
+var htmlSignatures = [][]byte{[]byte("<!doctype html"), []byte("<html")}
+
+// contentEncodings are the supported values of the Content-Encoding header of an item.
+var contentEncodings = map[string]struct{}{
+	"br":       {},
+	"compress": {},
+	"deflate":  {},
+	"gzip":     {},
+	"identity": {},
+	"zstd":     {},
+}
+
+// DetectContentType returns the content type of an item, derived from the extension of its path or, for unknown
+// extensions, from the leading bytes of its content. Contents matching no signature are plain text if they are valid
+// UTF-8 without control characters, and application/octet-stream otherwise. The detection only relies on tables of
+// the module since the content type is stored on chain.
+func DetectContentType(p string, content []byte) string {
+	if contentType, ok := contentTypes[strings.ToLower(path.Ext(p))]; ok {
+		return contentType
+	}
+	for _, signature := range contentSignatures {
+		if bytes.HasPrefix(content, signature.prefix) {
+			return signature.contentType
+		}
+	}
+	if !isText(content) {
+		return "application/octet-stream"
+	}
+	start := bytes.ToLower(bytes.TrimLeft(content[:min(len(content), 64)], " \t\n\r\f"))
+	for _, signature := range htmlSignatures {
+		if bytes.HasPrefix(start, signature) {
+			return "text/html; charset=utf-8"
+		}
+	}
+	return "text/plain; charset=utf-8"
+}
+
+// isText returns true if the content is valid UTF-8 without control characters other than whitespace.
+func isText(content []byte) bool {
+	if !utf8.Valid(content) {
+		return false
+	}
+	for _, b := range content {
+		if (b < ' ' && b != '\t' && b != '\n' && b != '\r' && b != '\f') || b == 0x7f {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateItemMeta validates the content type and HTTP headers of an item, if any.
+func ValidateItemMeta(meta *ItemMeta) error {
+	if contentType := meta.GetContentType(); contentType != "" {
+		if len(contentType) > MaxHeaderLength || !isHeaderValue(contentType) {
+			return fmt.Errorf(InvalidContentType, contentType)
+		}
+		if _, _, err := mime.ParseMediaType(contentType); err != nil {
+			return fmt.Errorf(InvalidContentType, contentType)
+		}
+	}
+	if cacheControl := meta.GetCacheControl(); cacheControl != "" {
+		if len(cacheControl) > MaxHeaderLength || !isHeaderValue(cacheControl) {
+			return fmt.Errorf(InvalidCacheControl, cacheControl)
+		}
+	}
+	if contentEncoding := meta.GetContentEncoding(); contentEncoding != "" {
+		if _, ok := contentEncodings[contentEncoding]; !ok {
+			return fmt.Errorf(InvalidContentEncoding, contentEncoding)
+		}
+	}
+
+	return nil
+}
+
+// isHeaderValue returns true if the value only contains printable ASCII characters and spaces.
+func isHeaderValue(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < ' ' || value[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
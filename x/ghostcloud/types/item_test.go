@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectContentType(t *testing.T) {
+	tests := []struct {
+		path     string
+		content  []byte
+		expected string
+	}{
+		{path: "index.html", content: []byte("<h1>Hello</h1>"), expected: "text/html; charset=utf-8"},
+		{path: "css/STYLE.CSS", content: []byte("body {}"), expected: "text/css; charset=utf-8"},
+		{path: "app.mjs", content: []byte("export {}"), expected: "text/javascript; charset=utf-8"},
+		{path: "fonts/font.woff2", content: []byte{0x77, 0x4f, 0x46, 0x32}, expected: "font/woff2"},
+		{path: "logo", content: []byte("\x89PNG\x0D\x0A\x1A\x0A"), expected: "image/png"},
+		{path: "README", content: []byte("Hello"), expected: "text/plain; charset=utf-8"},
+		{path: "blob.bin", content: []byte{0x00, 0x01, 0x02}, expected: "application/octet-stream"},
+		{path: "photo", content: []byte("\xff\xd8\xff\xe0"), expected: "image/jpeg"},
+		{path: "module", content: []byte("\x00asm\x01\x00\x00\x00"), expected: "application/wasm"},
+		{path: "page", content: []byte("\n<!DOCTYPE html><html></html>"), expected: "text/html; charset=utf-8"},
+		{path: "CNAME", content: []byte("example.com\n"), expected: "text/plain; charset=utf-8"},
+		{path: "latin1", content: []byte("caf\xe9"), expected: "application/octet-stream"},
+		// Formats sniffed by http.DetectContentType but not by the module are not detected
+		{path: "sound", content: []byte("OggS\x00\x02"), expected: "application/octet-stream"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			require.Equal(t, tt.expected, types.DetectContentType(tt.path, tt.content))
+		})
+	}
+}
+
+func TestValidateItemMeta(t *testing.T) {
+	tests := []struct {
+		name string
+		meta *types.ItemMeta
+		err  string
+	}{
+		{
+			name: "no headers",
+			meta: &types.ItemMeta{Path: "index.html"},
+		}, {
+			name: "all headers",
+			meta: &types.ItemMeta{Path: "app.js.gz", ContentType: "text/javascript; charset=utf-8", CacheControl: "public, max-age=31536000, immutable", ContentEncoding: "gzip"},
+		}, {
+			name: "invalid content type",
+			meta: &types.ItemMeta{Path: "index.html", ContentType: "text/"},
+			err:  "invalid content type",
+		}, {
+			name: "content type with newline",
+			meta: &types.ItemMeta{Path: "index.html", ContentType: "text/html\r\nX-Foo: bar"},
+			err:  "invalid content type",
+		}, {
+			name: "cache control with newline",
+			meta: &types.ItemMeta{Path: "index.html", CacheControl: "no-cache\nX-Foo: bar"},
+			err:  "invalid cache control",
+		}, {
+			name: "cache control too long",
+			meta: &types.ItemMeta{Path: "index.html", CacheControl: string(make([]byte, types.MaxHeaderLength+1))},
+			err:  "invalid cache control",
+		}, {
+			name: "unknown content encoding",
+			meta: &types.ItemMeta{Path: "index.html", ContentEncoding: "rot13"},
+			err:  "invalid content encoding",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := types.ValidateItemMeta(tt.meta)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -1,6 +1,15 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -17,42 +26,228 @@ const (
 )
 
 var (
-	DeploymentMetaKeyPrefix     = []byte{0x00}
-	DeploymentItemKeyPrefix     = []byte{0x01}
-	DeploymentItemMetaPrefix    = []byte{DeploymentItemKeyPrefix[0], 0x00}
-	DeploymentItemContentPrefix = []byte{DeploymentItemKeyPrefix[0], 0x01}
+	DeploymentMetaKeyPrefix   = []byte{0x04}
+	DeploymentItemKeyPrefix   = []byte{0x05}
+	DeploymentItemMetaPrefix  = []byte{DeploymentItemKeyPrefix[0], 0x00}
+	DeploymentDomainKeyPrefix = []byte{0x02}
+	DomainClaimKeyPrefix      = []byte{0x06}
+
+	// DeploymentCreationHeightKeyPrefix indexes the deployments by creation height.
+	DeploymentCreationHeightKeyPrefix = []byte{0x07}
+
+	// BlobKeyPrefix stores the item contents by hash, BlobRefCountKeyPrefix counts the items referencing each content.
+	BlobKeyPrefix         = []byte{0x08}
+	BlobRefCountKeyPrefix = []byte{0x09}
+
+	// UploadSessionKeyPrefix stores the upload sessions by id, UploadChunkKeyPrefix the files uploaded to each session
+	// and UploadExpiryKeyPrefix indexes the sessions by expiry height.
+	UploadSessionKeyPrefix = []byte{0x0A}
+	UploadChunkKeyPrefix   = []byte{0x0B}
+	UploadExpiryKeyPrefix  = []byte{0x0C}
+	UploadSessionSeqKey    = []byte{0x0D}
+
+	// UploadCreatorKeyPrefix indexes the upload sessions by creator.
+	UploadCreatorKeyPrefix = []byte{0x19}
+
+	// RevisionKeyPrefix stores the revisions of each deployment by number.
+	RevisionKeyPrefix = []byte{0x0E}
+
+	// ParamsKey stores the module params.
+	ParamsKey = []byte{0x0F}
+
+	// DeploymentExpiryKeyPrefix indexes the expiring deployments by expiry height.
+	DeploymentExpiryKeyPrefix = []byte{0x10}
+
+	// DeploymentIdKeyPrefix indexes the deployments by stable identifier, DeploymentSeqKey stores the last assigned
+	// identifier.
+	DeploymentIdKeyPrefix = []byte{0x11}
+	DeploymentSeqKey      = []byte{0x12}
+
+	// TransferKeyPrefix stores the pending ownership transfers by deployment key.
+	TransferKeyPrefix = []byte{0x13}
+
+	// CollaboratorKeyPrefix stores the collaborators of each deployment by address.
+	CollaboratorKeyPrefix = []byte{0x14}
+
+	// DeploymentUpdateHeightKeyPrefix, DeploymentNameKeyPrefix and DeploymentTotalSizeKeyPrefix index the deployments
+	// by last update height, name and total size. The value of each entry is the deployment key.
+	DeploymentUpdateHeightKeyPrefix = []byte{0x15}
+	DeploymentNameKeyPrefix         = []byte{0x16}
+	DeploymentTotalSizeKeyPrefix    = []byte{0x17}
+
+	// BlobEncodingKeyPrefix stores the encoding of the item contents not stored as is, by hash.
+	BlobEncodingKeyPrefix = []byte{0x18}
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// DeploymentItemKey returns the store key of a deployment item, i.e., the deployment key followed by the item path.
 func DeploymentItemKey(
 	addr sdk.AccAddress,
 	name string,
 	file string,
 ) []byte {
-	var key []byte
-
-	addrBytes := []byte(addr)
-	nameBytes := []byte(name)
-	key = append(key, addrBytes...)
-	key = append(key, nameBytes...)
-	key = append(key, file...)
+	return append(DeploymentKey(addr, name), file...)
+}
 
-	return key
+// ValidateNameKey returns an error if a deployment name does not fit in the length-prefixed store keys, see
+// MaxNameSizeLimit. Names read from queries and genesis files are checked before building their keys.
+func ValidateNameKey(name string) error {
+	if int64(len(name)) > MaxNameSizeLimit {
+		return fmt.Errorf(NameTooLong, name)
+	}
+	return nil
 }
 
+// DeploymentKey returns the store key of a deployment, i.e., the length-prefixed creator address followed by the
+// length-prefixed deployment name. Length prefixes guarantee that no deployment key is a prefix of another one.
 func DeploymentKey(
 	addr sdk.AccAddress,
 	name string,
 ) []byte {
 	var key []byte
 
-	addrBytes := []byte(addr)
-	nameBytes := []byte(name)
-	key = append(key, addrBytes...)
-	key = append(key, nameBytes...)
+	key = append(key, CreatorKey(addr)...)
+	key = append(key, address.MustLengthPrefix([]byte(name))...)
 
 	return key
 }
+
+// CreatorKey returns the key prefix of all the deployments of a creator. Deployment keys start with the creator
+// address, so the primary store also acts as an index by creator.
+func CreatorKey(addr sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(addr)
+}
+
+// CreationHeightKey returns the creation height index key of a deployment, i.e., the big-endian creation height
+// followed by the deployment key.
+func CreationHeightKey(height int64, addr sdk.AccAddress, name string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), DeploymentKey(addr, name)...)
+}
+
+// ExpiryKey returns the expiry index key of a deployment, i.e., the big-endian expiry height followed by the
+// deployment key.
+func ExpiryKey(height int64, addr sdk.AccAddress, name string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), DeploymentKey(addr, name)...)
+}
+
+// UpdateHeightKey returns the update height index key of a deployment, i.e., the big-endian last update height
+// followed by the deployment key.
+func UpdateHeightKey(height int64, addr sdk.AccAddress, name string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), DeploymentKey(addr, name)...)
+}
+
+// NameKey returns the name index key of a deployment, i.e., the name and a zero byte, so that names sort
+// lexicographically, followed by the deployment key.
+func NameKey(addr sdk.AccAddress, name string) []byte {
+	key := append([]byte(name), 0x00)
+	return append(key, DeploymentKey(addr, name)...)
+}
+
+// TotalSizeKey returns the total size index key of a deployment, i.e., the big-endian total size followed by the
+// deployment key.
+func TotalSizeKey(size uint64, addr sdk.AccAddress, name string) []byte {
+	return append(sdk.Uint64ToBigEndian(size), DeploymentKey(addr, name)...)
+}
+
+// CollaboratorKey returns the store key of a collaborator, i.e., the deployment key followed by the length-prefixed
+// collaborator address.
+func CollaboratorKey(addr sdk.AccAddress, name string, collaborator sdk.AccAddress) []byte {
+	return append(DeploymentKey(addr, name), address.MustLengthPrefix(collaborator)...)
+}
+
+// DeploymentIdKey returns the identifier index key of a deployment.
+func DeploymentIdKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// UploadSessionKey returns the store key of an upload session.
+func UploadSessionKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// UploadChunkKey returns the store key of a file of an upload session.
+func UploadChunkKey(id uint64, path string) []byte {
+	return append(UploadSessionKey(id), path...)
+}
+
+// UploadExpiryKey returns the expiry index key of an upload session.
+func UploadExpiryKey(height int64, id uint64) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(height)), UploadSessionKey(id)...)
+}
+
+// UploadCreatorKey returns the creator index key of an upload session, i.e., the length-prefixed creator address
+// followed by the session id.
+func UploadCreatorKey(addr sdk.AccAddress, id uint64) []byte {
+	return append(CreatorKey(addr), UploadSessionKey(id)...)
+}
+
+// RevisionKey returns the store key of a revision of a deployment.
+func RevisionKey(addr sdk.AccAddress, name string, number uint64) []byte {
+	return append(DeploymentKey(addr, name), sdk.Uint64ToBigEndian(number)...)
+}
+
+// ParseDeploymentKey returns the creator address and deployment name of a deployment key. Any trailing byte, e.g.,
+// the path of a deployment item key, is returned as the remainder.
+func ParseDeploymentKey(key []byte) (addr sdk.AccAddress, name string, remainder []byte, err error) {
+	addrBytes, key, err := parseLengthPrefixed(key)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	nameBytes, key, err := parseLengthPrefixed(key)
+	if err != nil {
+		return nil, "", nil, err
+	}
+	return addrBytes, string(nameBytes), key, nil
+}
+
+func parseLengthPrefixed(b []byte) (value []byte, remainder []byte, err error) {
+	if len(b) == 0 {
+		return nil, nil, fmt.Errorf("missing length prefix")
+	}
+	l := int(b[0])
+	if len(b) < 1+l {
+		return nil, nil, fmt.Errorf("invalid length prefix: %d > %d", l, len(b)-1)
+	}
+	return b[1 : 1+l], b[1+l:], nil
+}
+
+// ContentHash returns the SHA-256 hash of an item content. Item contents are stored once per hash.
+func ContentHash(content []byte) []byte {
+	h := sha256.Sum256(content)
+	return h[:]
+}
+
+// DatasetHash returns the SHA-256 hash of a dataset, computed over the path and content hash of its items sorted by
+// path.
+func DatasetHash(items []*ItemMeta) []byte {
+	sorted := make([]*ItemMeta, len(items))
+	copy(sorted, items)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].GetPath() < sorted[j].GetPath() })
+
+	h := sha256.New()
+	for _, item := range sorted {
+		h.Write(sdk.Uint64ToBigEndian(uint64(len(item.GetPath()))))
+		h.Write([]byte(item.GetPath()))
+		h.Write(item.GetHash())
+	}
+	return h.Sum(nil)
+}
+
+// DomainKey returns the domain index key of a domain. Domains are case-insensitive.
+func DomainKey(domain string) []byte {
+	return []byte(NormalizeDomain(domain))
+}
+
+// NormalizeDomain returns the canonical form of a domain, i.e., lower case without trailing dot.
+func NormalizeDomain(domain string) string {
+	return strings.TrimSuffix(strings.ToLower(domain), ".")
+}
+
+// DomainClaimToken returns the challenge token of a domain claim.
+func DomainClaimToken(creator string, name string, domain string, height int64) string {
+	h := sha256.Sum256([]byte(fmt.Sprintf("%s/%s/%s/%d", creator, name, NormalizeDomain(domain), height)))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAcceptDeploymentRequest = "accept_deployment"
+)
+
+var _ sdk.Msg = &MsgAcceptDeploymentRequest{}
+
+func (msg *MsgAcceptDeploymentRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAcceptDeploymentRequest) Type() string {
+	return TypeMsgAcceptDeploymentRequest
+}
+
+func (msg *MsgAcceptDeploymentRequest) GetSigners() []sdk.AccAddress {
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{recipient}
+}
+
+func (msg *MsgAcceptDeploymentRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAcceptDeploymentRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidRecipientAddress, err)
+	}
+
+	return nil
+}
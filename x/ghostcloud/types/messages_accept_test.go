@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgAcceptDeployment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgAcceptDeploymentRequest
+		err  error
+	}{
+		{
+			name: "invalid creator",
+			msg:  types.MsgAcceptDeploymentRequest{Creator: "invalid-addr", Name: "foobar", Recipient: sample.AccAddress()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid recipient",
+			msg:  types.MsgAcceptDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar", Recipient: "invalid-addr"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.MsgAcceptDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar", Recipient: sample.AccAddress()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgAddCollaboratorRequest = "add_collaborator"
+)
+
+var _ sdk.Msg = &MsgAddCollaboratorRequest{}
+
+func (msg *MsgAddCollaboratorRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAddCollaboratorRequest) Type() string {
+	return TypeMsgAddCollaboratorRequest
+}
+
+func (msg *MsgAddCollaboratorRequest) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgAddCollaboratorRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgAddCollaboratorRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidSignerAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCollaboratorAddress, err)
+	}
+	if _, ok := CollaboratorRole_name[int32(msg.Role)]; !ok || msg.Role == CollaboratorRole_COLLABORATOR_ROLE_UNSPECIFIED {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, InvalidCollaboratorRole, msg.Role)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgAddCollaborator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgAddCollaboratorRequest
+		err  error
+	}{
+		{
+			name: "invalid signer",
+			msg:  types.MsgAddCollaboratorRequest{Signer: "invalid-addr", Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid creator",
+			msg:  types.MsgAddCollaboratorRequest{Signer: sample.AccAddress(), Creator: "invalid-addr", Name: "foobar", Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid collaborator",
+			msg:  types.MsgAddCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: "invalid-addr", Role: types.CollaboratorRole_COLLABORATOR_ROLE_EDITOR},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "unspecified role",
+			msg:  types.MsgAddCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress()},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "unknown role",
+			msg:  types.MsgAddCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress(), Role: 42},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid",
+			msg:  types.MsgAddCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress(), Role: types.CollaboratorRole_COLLABORATOR_ROLE_ADMIN},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
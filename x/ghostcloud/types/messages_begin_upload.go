@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgBeginUploadRequest = "begin_upload"
+)
+
+var _ sdk.Msg = &MsgBeginUploadRequest{}
+
+func (msg *MsgBeginUploadRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBeginUploadRequest) Type() string {
+	return TypeMsgBeginUploadRequest
+}
+
+func (msg *MsgBeginUploadRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBeginUploadRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgBeginUploadRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+
+	return nil
+}
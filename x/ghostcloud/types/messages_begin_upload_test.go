@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgBeginUpload_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgBeginUploadRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgBeginUploadRequest{Meta: sample.CreateMetaInvalidAddress()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.MsgBeginUploadRequest{Meta: sample.CreateMeta(0)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -22,7 +22,7 @@ func (msg *MsgCreateDeploymentRequest) Type() string {
 }
 
 func (msg *MsgCreateDeploymentRequest) GetSigners() []sdk.AccAddress {
-	creator, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	creator, err := sdk.AccAddressFromBech32(msg.GetMeta().GetCreator())
 	if err != nil {
 		panic(err)
 	}
@@ -35,7 +35,10 @@ func (msg *MsgCreateDeploymentRequest) GetSignBytes() []byte {
 }
 
 func (msg *MsgCreateDeploymentRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	if msg.GetMeta() == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, MetaIsRequired)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.GetMeta().GetCreator())
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
 	}
@@ -18,6 +18,10 @@ func TestMsgCreateDeployment_ValidateBasic(t *testing.T) {
 		err  error
 	}{
 		{
+			name: "missing meta",
+			msg:  types.MsgCreateDeploymentRequest{},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
 			name: "invalid address",
 			msg:  types.MsgCreateDeploymentRequest{Meta: sample.CreateMetaInvalidAddress()},
 			err:  sdkerrors.ErrInvalidAddress,
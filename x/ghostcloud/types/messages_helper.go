@@ -2,6 +2,7 @@ package types
 
 const (
 	InvalidCreatorAddress          = "invalid creator address: %s"
+	InvalidAuthorityAddress        = "invalid authority address: %s"
 	InvalidDomain                  = "invalid domain: %v"
 	CreatorShouldNotBeEmpty        = "creator should not be empty"
 	NameShouldNotBeEmpty           = "name should not be empty"
@@ -11,8 +12,36 @@ const (
 	DescriptionTooLong             = "description is too long: %s"
 	MetaIsRequired                 = "meta is required"
 	PayloadTooBig                  = "payload is too big: %d > %d"
+	UploadNotDelegable             = "upload session messages cannot be granted by a publish authorization: %s"
 	PayloadIsRequired              = "payload is required"
 	UncompressedSizeTooBig         = "total uncompressed size is too big: %d > %d"
 	IndexHtmlNotFound              = "index.html not found"
 	NothingToUpdate                = "nothing to update"
+	ItemNotFound                   = "item not found: %s"
+	DuplicatePath                  = "duplicate path: %s"
+	DomainAlreadyInUse             = "domain already in use: %s"
+	DomainIsRequired               = "domain is required"
+	DomainClaimNotFound            = "domain claim not found: %s"
+	InvalidAuthority               = "invalid authority: expected %s, got %s"
+	PathShouldNotBeEmpty           = "path should not be empty"
+	UploadSessionNotFound          = "upload session not found: %d"
+	TooManyUploadSessions          = "too many open upload sessions: %d"
+	UploadItemNotFound             = "no content uploaded for item: %s"
+	RevisionNotFound               = "revision not found: %d"
+	DepositOverflow                = "deposit overflow: %s per byte for %d bytes"
+	ExpiryHeightIsNegative         = "expiry height should not be negative: %d"
+	ExpiryHeightNotInFuture        = "expiry height must be after the current block height: %d <= %d"
+	ExpiryHeightNotExtended        = "expiry height must be after the current expiry height: %d <= %d"
+	DeploymentDoesNotExpire        = "deployment does not expire"
+	RenewalFeeOverflow             = "renewal fee overflow: %s per block for %d blocks"
+	InvalidRecipientAddress        = "invalid recipient address: %s"
+	TransferNotFound               = "transfer not found: %s"
+	InvalidSignerAddress           = "invalid signer address: %s"
+	InvalidCollaboratorAddress     = "invalid collaborator address: %s"
+	InvalidCollaboratorRole        = "invalid collaborator role: %s"
+	CollaboratorIsCreator          = "the creator cannot be a collaborator"
+	CollaboratorNotFound           = "collaborator not found: %s"
+	InvalidContentType             = "invalid content type: %s"
+	InvalidCacheControl            = "invalid cache control: %s"
+	InvalidContentEncoding         = "invalid content encoding: %s"
 )
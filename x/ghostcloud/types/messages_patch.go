@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgPatchDeploymentRequest = "patch_deployment"
+)
+
+var _ sdk.Msg = &MsgPatchDeploymentRequest{}
+
+func (msg *MsgPatchDeploymentRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPatchDeploymentRequest) Type() string {
+	return TypeMsgPatchDeploymentRequest
+}
+
+// SignerAddress returns the address signing the message, i.e., the signer if set and the creator otherwise.
+func (msg *MsgPatchDeploymentRequest) SignerAddress() string {
+	if msg.Signer != "" {
+		return msg.Signer
+	}
+	return msg.Creator
+}
+
+func (msg *MsgPatchDeploymentRequest) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.SignerAddress())
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgPatchDeploymentRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPatchDeploymentRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+	if msg.Signer != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidSignerAddress, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgPatchDeployment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgPatchDeploymentRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgPatchDeploymentRequest{Creator: "invalid-addr", Name: "foobar"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid signer",
+			msg:  types.MsgPatchDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar", Signer: "invalid-addr"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.MsgPatchDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar"},
+		}, {
+			name: "valid signer",
+			msg:  types.MsgPatchDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar", Signer: sample.AccAddress()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRemoveCollaboratorRequest = "remove_collaborator"
+)
+
+var _ sdk.Msg = &MsgRemoveCollaboratorRequest{}
+
+func (msg *MsgRemoveCollaboratorRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRemoveCollaboratorRequest) Type() string {
+	return TypeMsgRemoveCollaboratorRequest
+}
+
+func (msg *MsgRemoveCollaboratorRequest) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg *MsgRemoveCollaboratorRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRemoveCollaboratorRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidSignerAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCollaboratorAddress, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgRemoveCollaborator_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRemoveCollaboratorRequest
+		err  error
+	}{
+		{
+			name: "invalid signer",
+			msg:  types.MsgRemoveCollaboratorRequest{Signer: "invalid-addr", Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid creator",
+			msg:  types.MsgRemoveCollaboratorRequest{Signer: sample.AccAddress(), Creator: "invalid-addr", Name: "foobar", Address: sample.AccAddress()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid collaborator",
+			msg:  types.MsgRemoveCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: "invalid-addr"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid",
+			msg:  types.MsgRemoveCollaboratorRequest{Signer: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Address: sample.AccAddress()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
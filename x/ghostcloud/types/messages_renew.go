@@ -0,0 +1,46 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRenewDeploymentRequest = "renew_deployment"
+)
+
+var _ sdk.Msg = &MsgRenewDeploymentRequest{}
+
+func (msg *MsgRenewDeploymentRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRenewDeploymentRequest) Type() string {
+	return TypeMsgRenewDeploymentRequest
+}
+
+func (msg *MsgRenewDeploymentRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRenewDeploymentRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRenewDeploymentRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+	if msg.ExpiresAtHeight <= 0 {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, ExpiryHeightNotInFuture, msg.ExpiresAtHeight, 0)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgRenewDeployment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgRenewDeploymentRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgRenewDeploymentRequest{Creator: "invalid-addr", Name: "foobar", ExpiresAtHeight: 100},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing expiry height",
+			msg:  types.MsgRenewDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar"},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg:  types.MsgRenewDeploymentRequest{Creator: sample.AccAddress(), Name: "foobar", ExpiresAtHeight: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgRollbackDeploymentRequest = "rollback_deployment"
+)
+
+var _ sdk.Msg = &MsgRollbackDeploymentRequest{}
+
+func (msg *MsgRollbackDeploymentRequest) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRollbackDeploymentRequest) Type() string {
+	return TypeMsgRollbackDeploymentRequest
+}
+
+func (msg *MsgRollbackDeploymentRequest) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgRollbackDeploymentRequest) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgRollbackDeploymentRequest) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
+	}
+
+	return nil
+}
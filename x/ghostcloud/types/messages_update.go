@@ -20,12 +20,20 @@ func (msg *MsgUpdateDeploymentRequest) Type() string {
 	return TypeMsgUpdateDeploymentRequest
 }
 
+// SignerAddress returns the address signing the message, i.e., the signer if set and the creator otherwise.
+func (msg *MsgUpdateDeploymentRequest) SignerAddress() string {
+	if msg.Signer != "" {
+		return msg.Signer
+	}
+	return msg.GetMeta().GetCreator()
+}
+
 func (msg *MsgUpdateDeploymentRequest) GetSigners() []sdk.AccAddress {
-	creator, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	signer, err := sdk.AccAddressFromBech32(msg.SignerAddress())
 	if err != nil {
 		panic(err)
 	}
-	return []sdk.AccAddress{creator}
+	return []sdk.AccAddress{signer}
 }
 
 func (msg *MsgUpdateDeploymentRequest) GetSignBytes() []byte {
@@ -34,10 +42,18 @@ func (msg *MsgUpdateDeploymentRequest) GetSignBytes() []byte {
 }
 
 func (msg *MsgUpdateDeploymentRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Meta.Creator)
+	if msg.GetMeta() == nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, MetaIsRequired)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.GetMeta().GetCreator())
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidCreatorAddress, err)
 	}
+	if msg.Signer != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, InvalidSignerAddress, err)
+		}
+	}
 
 	return nil
 }
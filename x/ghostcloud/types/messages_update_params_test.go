@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgUpdateParamsRequest
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg:  types.MsgUpdateParamsRequest{Authority: "invalid-addr", Params: types.DefaultParams()},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid params",
+			msg:  types.MsgUpdateParamsRequest{Authority: sample.AccAddress(), Params: types.Params{}},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid params",
+			msg:  types.MsgUpdateParamsRequest{Authority: sample.AccAddress(), Params: types.DefaultParams()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
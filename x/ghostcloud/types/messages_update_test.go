@@ -18,12 +18,23 @@ func TestMsgUpdateDeployment_ValidateBasic(t *testing.T) {
 		err  error
 	}{
 		{
+			name: "missing meta",
+			msg:  types.MsgUpdateDeploymentRequest{},
+			err:  sdkerrors.ErrInvalidRequest,
+		}, {
 			name: "invalid address",
 			msg:  types.MsgUpdateDeploymentRequest{Meta: sample.CreateMetaInvalidAddress()},
 			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid signer",
+			msg:  types.MsgUpdateDeploymentRequest{Meta: sample.CreateMeta(0), Signer: "invalid-addr"},
+			err:  sdkerrors.ErrInvalidAddress,
 		}, {
 			name: "valid address",
 			msg:  types.MsgUpdateDeploymentRequest{Meta: sample.CreateMeta(0)},
+		}, {
+			name: "valid signer",
+			msg:  types.MsgUpdateDeploymentRequest{Meta: sample.CreateMeta(0), Signer: sample.AccAddress()},
 		},
 	}
 	for _, tt := range tests {
@@ -37,3 +48,12 @@ func TestMsgUpdateDeployment_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateDeployment_GetSigners(t *testing.T) {
+	meta := sample.CreateMeta(0)
+	msg := types.MsgUpdateDeploymentRequest{Meta: meta}
+	require.Equal(t, meta.Creator, msg.GetSigners()[0].String())
+
+	msg.Signer = sample.AccAddress()
+	require.Equal(t, msg.Signer, msg.GetSigners()[0].String())
+}
@@ -0,0 +1,39 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgUploadChunk_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgUploadChunkRequest
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg:  types.MsgUploadChunkRequest{Creator: "invalid-addr", SessionId: 1},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.MsgUploadChunkRequest{Creator: sample.AccAddress(), SessionId: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/testutil/sample"
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestMsgVerifyDomain_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  types.MsgVerifyDomainRequest
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg:  types.MsgVerifyDomainRequest{Authority: "invalid-addr", Creator: sample.AccAddress(), Name: "foobar", Domain: "example.com"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid creator",
+			msg:  types.MsgVerifyDomainRequest{Authority: sample.AccAddress(), Creator: "invalid-addr", Name: "foobar", Domain: "example.com"},
+			err:  sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg:  types.MsgVerifyDomainRequest{Authority: sample.AccAddress(), Creator: sample.AccAddress(), Name: "foobar", Domain: "example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
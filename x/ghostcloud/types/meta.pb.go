@@ -8,15 +8,23 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +37,28 @@ type Meta struct {
 	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	Domain      string `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	// domain_verified is true if the domain was verified through a domain claim. Only verified domains are routed by
+	// the gateway. It is set by the module, and reset when the domain changes.
+	DomainVerified bool `protobuf:"varint,5,opt,name=domain_verified,json=domainVerified,proto3" json:"domain_verified,omitempty"`
+	// created_at_height is the block height at which the deployment was created. It is set by the module.
+	CreatedAtHeight int64 `protobuf:"varint,6,opt,name=created_at_height,json=createdAtHeight,proto3" json:"created_at_height,omitempty"`
+	// deposit is the amount escrowed from the creator for the files of the deployment. It is set by the module.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// expires_at_height is the block height at the end of which the deployment is removed, 0 if it does not expire.
+	ExpiresAtHeight int64 `protobuf:"varint,8,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+	// id is the stable identifier of the deployment, preserved by ownership transfers. It is set by the module.
+	Id uint64 `protobuf:"varint,9,opt,name=id,proto3" json:"id,omitempty"`
+	// updated_at_height is the block height at which the deployment was last created or updated. It is set by the
+	// module.
+	UpdatedAtHeight int64 `protobuf:"varint,10,opt,name=updated_at_height,json=updatedAtHeight,proto3" json:"updated_at_height,omitempty"`
+	// total_size is the total size of the files of the deployment, in bytes. It is set by the module.
+	TotalSize uint64 `protobuf:"varint,11,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// created_at_time is the block time at which the deployment was created. It is set by the module.
+	CreatedAtTime time.Time `protobuf:"bytes,12,opt,name=created_at_time,json=createdAtTime,proto3,stdtime" json:"created_at_time"`
+	// updated_at_time is the block time at which the deployment was last created or updated. It is set by the module.
+	UpdatedAtTime time.Time `protobuf:"bytes,13,opt,name=updated_at_time,json=updatedAtTime,proto3,stdtime" json:"updated_at_time"`
+	// version is the number of times the deployment was created or updated. It is set by the module.
+	Version uint64 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *Meta) Reset()         { *m = Meta{} }
@@ -92,6 +122,76 @@ func (m *Meta) GetDomain() string {
 	return ""
 }
 
+func (m *Meta) GetDomainVerified() bool {
+	if m != nil {
+		return m.DomainVerified
+	}
+	return false
+}
+
+func (m *Meta) GetCreatedAtHeight() int64 {
+	if m != nil {
+		return m.CreatedAtHeight
+	}
+	return 0
+}
+
+func (m *Meta) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Meta) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+func (m *Meta) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Meta) GetUpdatedAtHeight() int64 {
+	if m != nil {
+		return m.UpdatedAtHeight
+	}
+	return 0
+}
+
+func (m *Meta) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *Meta) GetCreatedAtTime() time.Time {
+	if m != nil {
+		return m.CreatedAtTime
+	}
+	return time.Time{}
+}
+
+func (m *Meta) GetUpdatedAtTime() time.Time {
+	if m != nil {
+		return m.UpdatedAtTime
+	}
+	return time.Time{}
+}
+
+func (m *Meta) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Meta)(nil), "ghostcloud.ghostcloud.Meta")
 }
@@ -99,21 +199,39 @@ func init() {
 func init() { proto.RegisterFile("ghostcloud/ghostcloud/meta.proto", fileDescriptor_e52727dd057fac0e) }
 
 var fileDescriptor_e52727dd057fac0e = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xcf, 0xc8, 0x2f,
-	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0xe6, 0xa6, 0x96, 0x24, 0xea, 0x15, 0x14,
-	0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x84, 0xf5, 0x10, 0x4c, 0x29, 0xc9, 0xe4, 0xfc, 0xe2, 0xdc,
-	0xfc, 0xe2, 0x78, 0xb0, 0x22, 0x7d, 0x08, 0x07, 0xa2, 0x43, 0xa9, 0x83, 0x91, 0x8b, 0xc5, 0x37,
-	0xb5, 0x24, 0x51, 0xc8, 0x88, 0x8b, 0x3d, 0xb9, 0x28, 0x35, 0xb1, 0x24, 0xbf, 0x48, 0x82, 0x51,
-	0x81, 0x51, 0x83, 0xd3, 0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x5a, 0xc7, 0x94, 0x94, 0xa2,
-	0xd4, 0xe2, 0xe2, 0xe0, 0x92, 0xa2, 0xcc, 0xbc, 0xf4, 0x20, 0x98, 0x42, 0x21, 0x21, 0x2e, 0x96,
-	0xbc, 0xc4, 0xdc, 0x54, 0x09, 0x26, 0x90, 0x86, 0x20, 0x30, 0x5b, 0x48, 0x81, 0x8b, 0x3b, 0x25,
-	0xb5, 0x38, 0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x19, 0x2c, 0x85, 0x2c, 0x24,
-	0x24, 0xc6, 0xc5, 0x96, 0x92, 0x9f, 0x9b, 0x98, 0x99, 0x27, 0xc1, 0x02, 0x96, 0x84, 0xf2, 0x9c,
-	0xcc, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f,
-	0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x16, 0xc9, 0xb7, 0x15,
-	0xc8, 0x5e, 0x2f, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x7b, 0xc5, 0x18, 0x10, 0x00, 0x00,
-	0xff, 0xff, 0x74, 0xc1, 0xbf, 0x02, 0x20, 0x01, 0x00, 0x00,
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xbd, 0x6e, 0xdb, 0x30,
+	0x10, 0xb6, 0x6c, 0xc7, 0x3f, 0x74, 0x63, 0xa3, 0x44, 0x5a, 0x30, 0x06, 0x22, 0x0b, 0x5d, 0x2a,
+	0x14, 0x88, 0xd4, 0xb8, 0x43, 0xe7, 0xb8, 0x4b, 0x87, 0x76, 0x51, 0x8a, 0x0e, 0x5d, 0x0c, 0x5a,
+	0xbc, 0xc8, 0x44, 0x2d, 0x51, 0x10, 0x69, 0x23, 0xcd, 0xd6, 0x37, 0xc8, 0x73, 0x74, 0xee, 0x43,
+	0x64, 0x0c, 0x3a, 0x75, 0x6a, 0x0a, 0xfb, 0x45, 0x0a, 0x91, 0x94, 0x23, 0x74, 0xcb, 0xa4, 0xbb,
+	0xef, 0xe3, 0x7d, 0xfa, 0xee, 0x78, 0x44, 0x5e, 0xb2, 0x14, 0x52, 0xc5, 0x2b, 0xb1, 0x66, 0x61,
+	0x2d, 0x4c, 0x41, 0xd1, 0x20, 0x2f, 0x84, 0x12, 0xf8, 0xd9, 0x03, 0x1c, 0x3c, 0x84, 0xe3, 0xe3,
+	0x58, 0xc8, 0x54, 0xc8, 0xb9, 0x3e, 0x14, 0x9a, 0xc4, 0x54, 0x8c, 0x5d, 0x93, 0x85, 0x0b, 0x2a,
+	0x21, 0xdc, 0x9c, 0x2d, 0x40, 0xd1, 0xb3, 0x30, 0x16, 0x3c, 0xb3, 0xfc, 0x51, 0x22, 0x12, 0x61,
+	0xea, 0xca, 0xc8, 0xa2, 0x93, 0x44, 0x88, 0x64, 0x05, 0xa1, 0xce, 0x16, 0xeb, 0xcb, 0x50, 0xf1,
+	0x14, 0xa4, 0xa2, 0x69, 0x6e, 0x0e, 0xbc, 0xf8, 0x7e, 0x80, 0xda, 0x1f, 0x41, 0x51, 0x3c, 0x45,
+	0xdd, 0xb8, 0x00, 0xaa, 0x44, 0x41, 0x1c, 0xcf, 0xf1, 0xfb, 0x33, 0xf2, 0xeb, 0xe7, 0xe9, 0x91,
+	0xb5, 0x70, 0xce, 0x58, 0x01, 0x52, 0x5e, 0xa8, 0x82, 0x67, 0x49, 0x54, 0x1d, 0xc4, 0x18, 0xb5,
+	0x33, 0x9a, 0x02, 0x69, 0x96, 0x05, 0x91, 0x8e, 0xb1, 0x87, 0x06, 0x0c, 0x64, 0x5c, 0xf0, 0x5c,
+	0x71, 0x91, 0x91, 0x96, 0xa6, 0xea, 0x10, 0x7e, 0x8e, 0x3a, 0x4c, 0xa4, 0x94, 0x67, 0xa4, 0xad,
+	0x49, 0x9b, 0xe1, 0x97, 0x68, 0x64, 0xa2, 0xf9, 0x06, 0x0a, 0x7e, 0xc9, 0x81, 0x91, 0x03, 0xcf,
+	0xf1, 0x7b, 0xd1, 0xd0, 0xc0, 0x9f, 0x2d, 0x8a, 0x5f, 0xa1, 0xa7, 0xda, 0x01, 0xb0, 0x39, 0x55,
+	0xf3, 0x25, 0xf0, 0x64, 0xa9, 0x48, 0xc7, 0x73, 0xfc, 0x56, 0x34, 0xb2, 0xc4, 0xb9, 0x7a, 0xaf,
+	0x61, 0x0c, 0xa8, 0xcb, 0x20, 0x17, 0x92, 0x2b, 0xd2, 0xf5, 0x5a, 0xfe, 0x60, 0x7a, 0x1c, 0xd8,
+	0x9e, 0xca, 0x41, 0x06, 0x76, 0x90, 0xc1, 0x3b, 0xc1, 0xb3, 0xd9, 0xeb, 0xdb, 0x3f, 0x93, 0xc6,
+	0x8f, 0xfb, 0x89, 0x9f, 0x70, 0xb5, 0x5c, 0x2f, 0x82, 0x58, 0xa4, 0xf6, 0x0e, 0xec, 0xe7, 0x54,
+	0xb2, 0xaf, 0xa1, 0xfa, 0x96, 0x83, 0xd4, 0x05, 0x32, 0xaa, 0xb4, 0x4b, 0x4b, 0x70, 0x95, 0xf3,
+	0x02, 0x64, 0xcd, 0x52, 0xcf, 0x58, 0xb2, 0xc4, 0xde, 0xd2, 0x10, 0x35, 0x39, 0x23, 0x7d, 0xcf,
+	0xf1, 0xdb, 0x51, 0x93, 0xeb, 0x76, 0xd6, 0x39, 0xfb, 0xaf, 0x1d, 0x64, 0x6a, 0x2d, 0xb1, 0xaf,
+	0x3d, 0x41, 0x48, 0x09, 0x45, 0x57, 0x73, 0xc9, 0xaf, 0x81, 0x0c, 0xb4, 0x46, 0x5f, 0x23, 0x17,
+	0xfc, 0x1a, 0xf0, 0x07, 0x34, 0xaa, 0x4d, 0xa6, 0xbc, 0x6b, 0xf2, 0xc4, 0x73, 0xfc, 0xc1, 0x74,
+	0x1c, 0x98, 0x45, 0x08, 0xaa, 0x45, 0x08, 0x3e, 0x55, 0x8b, 0x30, 0xeb, 0x95, 0x6d, 0xdf, 0xdc,
+	0x4f, 0x9c, 0xe8, 0x70, 0x3f, 0xbd, 0x92, 0x2d, 0xd5, 0x6a, 0xc6, 0xb4, 0xda, 0xe1, 0x63, 0xd4,
+	0xf6, 0xe6, 0xb5, 0x1a, 0x41, 0xdd, 0x0d, 0x14, 0xb2, 0x5c, 0x8a, 0xa1, 0xf6, 0x5d, 0xa5, 0xb3,
+	0xb7, 0xb7, 0x5b, 0xd7, 0xb9, 0xdb, 0xba, 0xce, 0xdf, 0xad, 0xeb, 0xdc, 0xec, 0xdc, 0xc6, 0xdd,
+	0xce, 0x6d, 0xfc, 0xde, 0xb9, 0x8d, 0x2f, 0x27, 0xb5, 0xd7, 0x73, 0x55, 0x7f, 0x4a, 0xfa, 0x12,
+	0x16, 0x1d, 0xfd, 0xff, 0x37, 0xff, 0x06, 0x00, 0x9b, 0xb2, 0x29, 0xf7, 0x70, 0x03, 0x00, 0x00,
 }
 
 func (m *Meta) Marshal() (dAtA []byte, err error) {
@@ -136,6 +254,76 @@ func (m *Meta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Version != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x70
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAtTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMeta(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAtTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAtTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMeta(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x62
+	if m.TotalSize != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.TotalSize))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.UpdatedAtHeight != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.UpdatedAtHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Id != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMeta(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CreatedAtHeight != 0 {
+		i = encodeVarintMeta(dAtA, i, uint64(m.CreatedAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DomainVerified {
+		i--
+		if m.DomainVerified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Domain) > 0 {
 		i -= len(m.Domain)
 		copy(dAtA[i:], m.Domain)
@@ -200,6 +388,37 @@ func (m *Meta) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMeta(uint64(l))
 	}
+	if m.DomainVerified {
+		n += 2
+	}
+	if m.CreatedAtHeight != 0 {
+		n += 1 + sovMeta(uint64(m.CreatedAtHeight))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovMeta(uint64(l))
+		}
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovMeta(uint64(m.ExpiresAtHeight))
+	}
+	if m.Id != 0 {
+		n += 1 + sovMeta(uint64(m.Id))
+	}
+	if m.UpdatedAtHeight != 0 {
+		n += 1 + sovMeta(uint64(m.UpdatedAtHeight))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovMeta(uint64(m.TotalSize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAtTime)
+	n += 1 + l + sovMeta(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAtTime)
+	n += 1 + l + sovMeta(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovMeta(uint64(m.Version))
+	}
 	return n
 }
 
@@ -366,6 +585,240 @@ func (m *Meta) Unmarshal(dAtA []byte) error {
 			}
 			m.Domain = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DomainVerified = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtHeight", wireType)
+			}
+			m.CreatedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAtHeight", wireType)
+			}
+			m.UpdatedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAtTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMeta
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMeta
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAtTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMeta
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMeta(dAtA[iNdEx:])
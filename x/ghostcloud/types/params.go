@@ -1,21 +1,47 @@
 package types
 
 import (
+	"fmt"
+
 	"gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 const (
-	DefaultMaxPayloadSize      int64  = 1024 * 1024 * 5 // 5MB
-	DefaultMaxNameSize         int64  = 12
-	DefaultMaxDescriptionSize  int64  = 512
-	DefaultMaxUncompressedSize uint64 = 1024 * 1024 * 50 // 50MB
+	DefaultMaxPayloadSize              int64  = 1024 * 1024 * 5 // 5MB
+	DefaultMaxNameSize                 int64  = 12
+	DefaultMaxDescriptionSize          int64  = 512
+	DefaultMaxUncompressedSize         uint64 = 1024 * 1024 * 50 // 50MB
+	DefaultUploadSessionTTL            int64  = 1200             // blocks
+	DefaultExpiredUploadPruneLimit     uint64 = 100
+	DefaultMaxUploadSessionsPerCreator uint64 = 10
+	DefaultMaxRevisions                uint64 = 10
+	DefaultExpiredPruneLimit           uint64 = 100
+
+	// MaxNameSizeLimit is the maximum name size supported by the length-prefixed store keys
+	MaxNameSizeLimit int64 = 255
 )
 
-// ParamKeyTable the param key table for launch module
+// Parameter store keys of the legacy x/params subspace, only used by the migration to the module-owned params.
+var (
+	KeyMaxPayloadSize              = []byte("MaxPayloadSize")
+	KeyMaxNameSize                 = []byte("MaxNameSize")
+	KeyMaxDescriptionSize          = []byte("MaxDescriptionSize")
+	KeyMaxUncompressedSize         = []byte("MaxUncompressedSize")
+	KeyUploadSessionTTL            = []byte("UploadSessionTtl")
+	KeyExpiredUploadPruneLimit     = []byte("ExpiredUploadPruneLimit")
+	KeyMaxUploadSessionsPerCreator = []byte("MaxUploadSessionsPerCreator")
+	KeyMaxRevisions                = []byte("MaxRevisions")
+	KeyDepositPerByte              = []byte("DepositPerByte")
+	KeyRenewalFeePerBlock          = []byte("RenewalFeePerBlock")
+	KeyExpiredPruneLimit           = []byte("ExpiredPruneLimit")
+)
+
+// ParamKeyTable the param key table for launch module, only used by the migration to the module-owned params
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
@@ -23,10 +49,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		MaxPayloadSize:      DefaultMaxPayloadSize,
-		MaxNameSize:         DefaultMaxNameSize,
-		MaxDescriptionSize:  DefaultMaxDescriptionSize,
-		MaxUncompressedSize: DefaultMaxUncompressedSize,
+		MaxPayloadSize:              DefaultMaxPayloadSize,
+		MaxNameSize:                 DefaultMaxNameSize,
+		MaxDescriptionSize:          DefaultMaxDescriptionSize,
+		MaxUncompressedSize:         DefaultMaxUncompressedSize,
+		UploadSessionTtl:            DefaultUploadSessionTTL,
+		ExpiredUploadPruneLimit:     DefaultExpiredUploadPruneLimit,
+		MaxUploadSessionsPerCreator: DefaultMaxUploadSessionsPerCreator,
+		MaxRevisions:                DefaultMaxRevisions,
+		ExpiredPruneLimit:           DefaultExpiredPruneLimit,
 	}
 }
 
@@ -37,11 +68,56 @@ func DefaultParams() Params {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxPayloadSize, &p.MaxPayloadSize, validateMaxPayloadSize),
+		paramtypes.NewParamSetPair(KeyMaxNameSize, &p.MaxNameSize, validateMaxNameSize),
+		paramtypes.NewParamSetPair(KeyMaxDescriptionSize, &p.MaxDescriptionSize, validateMaxDescriptionSize),
+		paramtypes.NewParamSetPair(KeyMaxUncompressedSize, &p.MaxUncompressedSize, validateMaxUncompressedSize),
+		paramtypes.NewParamSetPair(KeyUploadSessionTTL, &p.UploadSessionTtl, validateUploadSessionTTL),
+		paramtypes.NewParamSetPair(KeyExpiredUploadPruneLimit, &p.ExpiredUploadPruneLimit, validateExpiredUploadPruneLimit),
+		paramtypes.NewParamSetPair(KeyMaxUploadSessionsPerCreator, &p.MaxUploadSessionsPerCreator, validateMaxUploadSessionsPerCreator),
+		paramtypes.NewParamSetPair(KeyMaxRevisions, &p.MaxRevisions, validateMaxRevisions),
+		paramtypes.NewParamSetPair(KeyDepositPerByte, &p.DepositPerByte, validateDepositPerByte),
+		paramtypes.NewParamSetPair(KeyRenewalFeePerBlock, &p.RenewalFeePerBlock, validateRenewalFeePerBlock),
+		paramtypes.NewParamSetPair(KeyExpiredPruneLimit, &p.ExpiredPruneLimit, validateExpiredPruneLimit),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMaxPayloadSize(p.MaxPayloadSize); err != nil {
+		return err
+	}
+	if err := validateMaxNameSize(p.MaxNameSize); err != nil {
+		return err
+	}
+	if err := validateMaxDescriptionSize(p.MaxDescriptionSize); err != nil {
+		return err
+	}
+	if err := validateMaxUncompressedSize(p.MaxUncompressedSize); err != nil {
+		return err
+	}
+	if err := validateUploadSessionTTL(p.UploadSessionTtl); err != nil {
+		return err
+	}
+	if err := validateExpiredUploadPruneLimit(p.ExpiredUploadPruneLimit); err != nil {
+		return err
+	}
+	if err := validateMaxUploadSessionsPerCreator(p.MaxUploadSessionsPerCreator); err != nil {
+		return err
+	}
+	if err := validateMaxRevisions(p.MaxRevisions); err != nil {
+		return err
+	}
+	if err := validateDepositPerByte(p.DepositPerByte); err != nil {
+		return err
+	}
+	if err := validateRenewalFeePerBlock(p.RenewalFeePerBlock); err != nil {
+		return err
+	}
+	if err := validateExpiredPruneLimit(p.ExpiredPruneLimit); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -50,3 +126,127 @@ func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
 	return string(out)
 }
+
+func validateMaxPayloadSize(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max payload size must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateMaxNameSize(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("max name size must be positive: %d", v)
+	}
+	if v > MaxNameSizeLimit {
+		return fmt.Errorf("max name size is too big: %d > %d", v, MaxNameSizeLimit)
+	}
+	return nil
+}
+
+func validateMaxDescriptionSize(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max description size must not be negative: %d", v)
+	}
+	return nil
+}
+
+func validateMaxUncompressedSize(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max uncompressed size must be positive")
+	}
+	return nil
+}
+
+func validateUploadSessionTTL(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("upload session ttl must be positive: %d", v)
+	}
+	return nil
+}
+
+func validateExpiredUploadPruneLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("expired upload prune limit must be positive")
+	}
+	return nil
+}
+
+func validateMaxUploadSessionsPerCreator(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max upload sessions per creator must be positive")
+	}
+	return nil
+}
+
+func validateMaxRevisions(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("max revisions must be positive")
+	}
+	return nil
+}
+
+func validateDepositPerByte(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit per byte: %w", err)
+	}
+	return nil
+}
+
+func validateRenewalFeePerBlock(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid renewal fee per block: %w", err)
+	}
+	return nil
+}
+
+func validateExpiredPruneLimit(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("expired prune limit must be positive")
+	}
+	return nil
+}
@@ -11,6 +11,9 @@ import (
 
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,6 +33,21 @@ type Params struct {
 	MaxNameSize         int64  `protobuf:"varint,2,opt,name=max_name_size,json=maxNameSize,proto3" json:"max_name_size,omitempty"`
 	MaxDescriptionSize  int64  `protobuf:"varint,3,opt,name=max_description_size,json=maxDescriptionSize,proto3" json:"max_description_size,omitempty"`
 	MaxUncompressedSize uint64 `protobuf:"varint,4,opt,name=max_uncompressed_size,json=maxUncompressedSize,proto3" json:"max_uncompressed_size,omitempty"`
+	// upload_session_ttl is the number of blocks after which an uncommitted upload session expires.
+	UploadSessionTtl int64 `protobuf:"varint,5,opt,name=upload_session_ttl,json=uploadSessionTtl,proto3" json:"upload_session_ttl,omitempty"`
+	// expired_upload_prune_limit is the maximum number of expired upload sessions removed at the end of each block.
+	ExpiredUploadPruneLimit uint64 `protobuf:"varint,6,opt,name=expired_upload_prune_limit,json=expiredUploadPruneLimit,proto3" json:"expired_upload_prune_limit,omitempty"`
+	// max_upload_sessions_per_creator is the maximum number of upload sessions a creator may have open at once.
+	MaxUploadSessionsPerCreator uint64 `protobuf:"varint,7,opt,name=max_upload_sessions_per_creator,json=maxUploadSessionsPerCreator,proto3" json:"max_upload_sessions_per_creator,omitempty"`
+	// max_revisions is the number of revisions retained per deployment, including the live one.
+	MaxRevisions uint64 `protobuf:"varint,8,opt,name=max_revisions,json=maxRevisions,proto3" json:"max_revisions,omitempty"`
+	// deposit_per_byte is escrowed from the creator for each byte of the files of a deployment.
+	// The deposit is refunded when the deployment is removed.
+	DepositPerByte github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=deposit_per_byte,json=depositPerByte,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit_per_byte"`
+	// renewal_fee_per_block is paid to the fee collector for each block a deployment expiry is extended by.
+	RenewalFeePerBlock github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=renewal_fee_per_block,json=renewalFeePerBlock,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee_per_block"`
+	// expired_prune_limit is the maximum number of expired deployments removed at the end of each block.
+	ExpiredPruneLimit uint64 `protobuf:"varint,11,opt,name=expired_prune_limit,json=expiredPruneLimit,proto3" json:"expired_prune_limit,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -92,6 +110,55 @@ func (m *Params) GetMaxUncompressedSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetUploadSessionTtl() int64 {
+	if m != nil {
+		return m.UploadSessionTtl
+	}
+	return 0
+}
+
+func (m *Params) GetExpiredUploadPruneLimit() uint64 {
+	if m != nil {
+		return m.ExpiredUploadPruneLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxUploadSessionsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxUploadSessionsPerCreator
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRevisions() uint64 {
+	if m != nil {
+		return m.MaxRevisions
+	}
+	return 0
+}
+
+func (m *Params) GetDepositPerByte() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DepositPerByte
+	}
+	return nil
+}
+
+func (m *Params) GetRenewalFeePerBlock() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFeePerBlock
+	}
+	return nil
+}
+
+func (m *Params) GetExpiredPruneLimit() uint64 {
+	if m != nil {
+		return m.ExpiredPruneLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ghostcloud.ghostcloud.Params")
 }
@@ -101,24 +168,39 @@ func init() {
 }
 
 var fileDescriptor_0d0bbb6eb8def319 = []byte{
-	// 257 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0xcf, 0xc8, 0x2f,
-	0x2e, 0x49, 0xce, 0xc9, 0x2f, 0x4d, 0xd1, 0x47, 0x62, 0x16, 0x24, 0x16, 0x25, 0xe6, 0x16, 0xeb,
-	0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x89, 0x22, 0x24, 0xf4, 0x10, 0x4c, 0x29, 0x91, 0xf4, 0xfc,
-	0xf4, 0x7c, 0xb0, 0x0a, 0x7d, 0x10, 0x0b, 0xa2, 0x58, 0xe9, 0x10, 0x23, 0x17, 0x5b, 0x00, 0x58,
-	0xb7, 0x90, 0x06, 0x97, 0x40, 0x6e, 0x62, 0x45, 0x7c, 0x41, 0x62, 0x65, 0x4e, 0x7e, 0x62, 0x4a,
-	0x7c, 0x71, 0x66, 0x55, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x73, 0x10, 0x5f, 0x6e, 0x62, 0x45,
-	0x00, 0x44, 0x38, 0x38, 0xb3, 0x2a, 0x55, 0x48, 0x89, 0x8b, 0x17, 0xa4, 0x32, 0x2f, 0x31, 0x37,
-	0x15, 0xa2, 0x8c, 0x09, 0xac, 0x8c, 0x3b, 0x37, 0xb1, 0xc2, 0x2f, 0x31, 0x37, 0x15, 0xac, 0xc6,
-	0x80, 0x4b, 0x04, 0xa4, 0x26, 0x25, 0xb5, 0x38, 0xb9, 0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x0f,
-	0xa2, 0x94, 0x19, 0xac, 0x54, 0x28, 0x37, 0xb1, 0xc2, 0x05, 0x21, 0x05, 0xd6, 0x61, 0xc4, 0x25,
-	0x0a, 0xd2, 0x51, 0x9a, 0x97, 0x9c, 0x9f, 0x5b, 0x50, 0x94, 0x5a, 0x5c, 0x9c, 0x0a, 0x75, 0x04,
-	0x8b, 0x02, 0xa3, 0x06, 0x4b, 0x90, 0x70, 0x6e, 0x62, 0x45, 0x28, 0x92, 0x1c, 0x48, 0x8f, 0x15,
-	0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xe6, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x10, 0x25, 0x8b, 0x14, 0x48, 0x15, 0xc8, 0x21, 0x56, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x0e, 0x04, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x3f, 0x61, 0x09, 0x57, 0x01, 0x00,
-	0x00,
+	// 508 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x63, 0x9a, 0x06, 0xb8, 0x40, 0x15, 0xae, 0x8d, 0x30, 0x41, 0x38, 0x51, 0x58, 0x32,
+	0x80, 0xdd, 0x96, 0x01, 0x09, 0xb6, 0xb4, 0x62, 0x42, 0x28, 0x4a, 0xe9, 0xc2, 0x62, 0x9d, 0xed,
+	0x3f, 0xe9, 0xa9, 0x3e, 0xdf, 0xe9, 0xee, 0x5c, 0x9c, 0x0e, 0x7c, 0x06, 0x46, 0x46, 0x66, 0xbe,
+	0x04, 0x6b, 0xc7, 0x8e, 0x4c, 0x80, 0x92, 0x2f, 0x82, 0xee, 0xce, 0x55, 0xcc, 0xce, 0xe4, 0x93,
+	0xdf, 0xef, 0xdd, 0xfb, 0xeb, 0x9e, 0xfe, 0x68, 0xbc, 0x38, 0xe3, 0x4a, 0xa7, 0x39, 0x2f, 0xb3,
+	0xa8, 0x71, 0x14, 0x44, 0x12, 0xa6, 0x42, 0x21, 0xb9, 0xe6, 0xb8, 0xbf, 0x11, 0xc2, 0xcd, 0x71,
+	0xb0, 0xb7, 0xe0, 0x0b, 0x6e, 0x89, 0xc8, 0x9c, 0x1c, 0x3c, 0x08, 0x52, 0xae, 0x18, 0x57, 0x51,
+	0x42, 0x14, 0x44, 0x17, 0x07, 0x09, 0x68, 0x72, 0x10, 0xa5, 0x9c, 0x16, 0x4e, 0x1f, 0xff, 0xd8,
+	0x46, 0x9d, 0x99, 0xbd, 0x1d, 0x4f, 0x50, 0x8f, 0x91, 0x2a, 0x16, 0x64, 0x99, 0x73, 0x92, 0xc5,
+	0x8a, 0x5e, 0x82, 0xef, 0x8d, 0xbc, 0xc9, 0xd6, 0x7c, 0x87, 0x91, 0x6a, 0xe6, 0x7e, 0x9f, 0xd0,
+	0x4b, 0xc0, 0x63, 0x74, 0xdf, 0x90, 0x05, 0x61, 0xe0, 0xb0, 0x5b, 0x16, 0xeb, 0x32, 0x52, 0xbd,
+	0x23, 0x0c, 0x2c, 0xb3, 0x8f, 0xf6, 0x0c, 0x93, 0x81, 0x4a, 0x25, 0x15, 0x9a, 0xf2, 0xc2, 0xa1,
+	0x5b, 0x16, 0xc5, 0x8c, 0x54, 0xc7, 0x1b, 0xc9, 0x3a, 0x0e, 0x51, 0xdf, 0x38, 0xca, 0x22, 0xe5,
+	0x4c, 0x48, 0x50, 0x0a, 0xea, 0x21, 0xda, 0x23, 0x6f, 0xd2, 0x9e, 0xef, 0x32, 0x52, 0x9d, 0x36,
+	0x34, 0xeb, 0x79, 0x86, 0x70, 0x29, 0xdc, 0xb8, 0xa0, 0x94, 0x09, 0xd1, 0x3a, 0xf7, 0xb7, 0x6d,
+	0x46, 0xcf, 0x29, 0x27, 0x4e, 0x78, 0xaf, 0x73, 0xfc, 0x1a, 0x0d, 0xa0, 0x12, 0x54, 0x42, 0x16,
+	0xd7, 0x2e, 0x21, 0xcb, 0x02, 0xe2, 0x9c, 0x32, 0xaa, 0xfd, 0x8e, 0x8d, 0x79, 0x58, 0x13, 0xa7,
+	0x16, 0x98, 0x19, 0xfd, 0xad, 0x91, 0xf1, 0x31, 0x1a, 0xda, 0xf1, 0xfe, 0x89, 0x53, 0xb1, 0x00,
+	0x19, 0xa7, 0x12, 0x88, 0xe6, 0xd2, 0xbf, 0x6d, 0x6f, 0x78, 0x6c, 0x06, 0x6d, 0x46, 0xab, 0x19,
+	0xc8, 0x23, 0x87, 0xe0, 0xa7, 0xee, 0xe9, 0x24, 0x5c, 0x50, 0x2b, 0xf9, 0x77, 0xac, 0xe7, 0x1e,
+	0x23, 0xd5, 0xfc, 0xe6, 0x1f, 0x2e, 0x51, 0x2f, 0x03, 0xc1, 0x15, 0xd5, 0xf6, 0xfa, 0x64, 0xa9,
+	0xc1, 0xbf, 0x3b, 0xda, 0x9a, 0x74, 0x0f, 0x1f, 0x85, 0xae, 0xcf, 0xd0, 0xf4, 0x19, 0xd6, 0x7d,
+	0x86, 0x47, 0x9c, 0x16, 0xd3, 0xfd, 0xab, 0x5f, 0xc3, 0xd6, 0xf7, 0xdf, 0xc3, 0xc9, 0x82, 0xea,
+	0xb3, 0x32, 0x09, 0x53, 0xce, 0xa2, 0xba, 0x7c, 0xf7, 0x79, 0xae, 0xb2, 0xf3, 0x48, 0x2f, 0x05,
+	0x28, 0x6b, 0x50, 0xf3, 0x9d, 0x3a, 0x64, 0x06, 0x72, 0xba, 0xd4, 0x80, 0x3f, 0xa3, 0xbe, 0x84,
+	0x02, 0x3e, 0x91, 0x3c, 0xfe, 0x08, 0xe0, 0xa2, 0x73, 0x9e, 0x9e, 0xfb, 0xe8, 0xff, 0x67, 0xe3,
+	0x3a, 0xe9, 0x0d, 0x80, 0x89, 0x37, 0x31, 0x38, 0x44, 0xbb, 0x37, 0xf5, 0x34, 0x7b, 0xe9, 0xda,
+	0x17, 0x7a, 0x50, 0x4b, 0x9b, 0x46, 0x5e, 0xb5, 0xbf, 0x7e, 0x1b, 0xb6, 0xa6, 0x2f, 0xaf, 0x56,
+	0x81, 0x77, 0xbd, 0x0a, 0xbc, 0x3f, 0xab, 0xc0, 0xfb, 0xb2, 0x0e, 0x5a, 0xd7, 0xeb, 0xa0, 0xf5,
+	0x73, 0x1d, 0xb4, 0x3e, 0x3c, 0x69, 0x6c, 0x50, 0xd5, 0x5c, 0x27, 0x3b, 0x48, 0xd2, 0xb1, 0x1b,
+	0xf0, 0xe2, 0xef, 0x00, 0xc8, 0x6b, 0x6d, 0x73, 0x74, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +223,59 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiredPruneLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiredPruneLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RenewalFeePerBlock) > 0 {
+		for iNdEx := len(m.RenewalFeePerBlock) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFeePerBlock[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DepositPerByte) > 0 {
+		for iNdEx := len(m.DepositPerByte) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DepositPerByte[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.MaxRevisions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRevisions))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxUploadSessionsPerCreator != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxUploadSessionsPerCreator))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ExpiredUploadPruneLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExpiredUploadPruneLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UploadSessionTtl != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UploadSessionTtl))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.MaxUncompressedSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxUncompressedSize))
 		i--
@@ -193,6 +328,33 @@ func (m *Params) Size() (n int) {
 	if m.MaxUncompressedSize != 0 {
 		n += 1 + sovParams(uint64(m.MaxUncompressedSize))
 	}
+	if m.UploadSessionTtl != 0 {
+		n += 1 + sovParams(uint64(m.UploadSessionTtl))
+	}
+	if m.ExpiredUploadPruneLimit != 0 {
+		n += 1 + sovParams(uint64(m.ExpiredUploadPruneLimit))
+	}
+	if m.MaxUploadSessionsPerCreator != 0 {
+		n += 1 + sovParams(uint64(m.MaxUploadSessionsPerCreator))
+	}
+	if m.MaxRevisions != 0 {
+		n += 1 + sovParams(uint64(m.MaxRevisions))
+	}
+	if len(m.DepositPerByte) > 0 {
+		for _, e := range m.DepositPerByte {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RenewalFeePerBlock) > 0 {
+		for _, e := range m.RenewalFeePerBlock {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ExpiredPruneLimit != 0 {
+		n += 1 + sovParams(uint64(m.ExpiredPruneLimit))
+	}
 	return n
 }
 
@@ -307,6 +469,169 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadSessionTtl", wireType)
+			}
+			m.UploadSessionTtl = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UploadSessionTtl |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredUploadPruneLimit", wireType)
+			}
+			m.ExpiredUploadPruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredUploadPruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUploadSessionsPerCreator", wireType)
+			}
+			m.MaxUploadSessionsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUploadSessionsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRevisions", wireType)
+			}
+			m.MaxRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRevisions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositPerByte = append(m.DepositPerByte, types.Coin{})
+			if err := m.DepositPerByte[len(m.DepositPerByte)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFeePerBlock", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFeePerBlock = append(m.RenewalFeePerBlock, types.Coin{})
+			if err := m.RenewalFeePerBlock[len(m.RenewalFeePerBlock)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiredPruneLimit", wireType)
+			}
+			m.ExpiredPruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiredPruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
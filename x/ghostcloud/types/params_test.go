@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(p *types.Params)
+		err    string
+	}{
+		{name: "default", modify: func(p *types.Params) {}},
+		{name: "zero payload size", modify: func(p *types.Params) { p.MaxPayloadSize = 0 }, err: "max payload size must be positive"},
+		{name: "zero name size", modify: func(p *types.Params) { p.MaxNameSize = 0 }, err: "max name size must be positive"},
+		{name: "name size too big", modify: func(p *types.Params) { p.MaxNameSize = types.MaxNameSizeLimit + 1 }, err: "max name size is too big"},
+		{name: "zero description size", modify: func(p *types.Params) { p.MaxDescriptionSize = 0 }},
+		{name: "negative description size", modify: func(p *types.Params) { p.MaxDescriptionSize = -1 }, err: "max description size must not be negative"},
+		{name: "zero uncompressed size", modify: func(p *types.Params) { p.MaxUncompressedSize = 0 }, err: "max uncompressed size must be positive"},
+		{name: "zero upload session ttl", modify: func(p *types.Params) { p.UploadSessionTtl = 0 }, err: "upload session ttl must be positive"},
+		{name: "zero expired upload prune limit", modify: func(p *types.Params) { p.ExpiredUploadPruneLimit = 0 }, err: "expired upload prune limit must be positive"},
+		{name: "zero upload sessions per creator", modify: func(p *types.Params) { p.MaxUploadSessionsPerCreator = 0 }, err: "max upload sessions per creator must be positive"},
+		{name: "zero revisions", modify: func(p *types.Params) { p.MaxRevisions = 0 }, err: "max revisions must be positive"},
+		{name: "deposit per byte", modify: func(p *types.Params) { p.DepositPerByte = sdk.NewCoins(sdk.NewInt64Coin("stake", 1)) }},
+		{name: "renewal fee per block", modify: func(p *types.Params) { p.RenewalFeePerBlock = sdk.NewCoins(sdk.NewInt64Coin("stake", 1)) }},
+		{name: "zero renewal fee per block", modify: func(p *types.Params) { p.RenewalFeePerBlock = sdk.Coins{sdk.NewInt64Coin("stake", 0)} }, err: "invalid renewal fee per block"},
+		{name: "zero expired prune limit", modify: func(p *types.Params) { p.ExpiredPruneLimit = 0 }, err: "expired prune limit must be positive"},
+		{name: "zero deposit per byte", modify: func(p *types.Params) { p.DepositPerByte = sdk.Coins{sdk.NewInt64Coin("stake", 0)} }, err: "invalid deposit per byte"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tt.modify(&params)
+			err := params.Validate()
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"path"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// CanonicalPath validates an item path and returns its canonical form, i.e., without `.` segments. Empty, absolute
+// and traversing paths, and paths containing backslashes, empty segments or NUL bytes are rejected.
+func CanonicalPath(p string) (string, error) {
+	if p == "" {
+		return "", ErrEmptyPath
+	}
+	if strings.IndexByte(p, 0) >= 0 {
+		return "", errorsmod.Wrapf(ErrPathNulByte, "%q", p)
+	}
+	if strings.Contains(p, `\`) {
+		return "", errorsmod.Wrap(ErrPathBackslash, p)
+	}
+	if strings.HasPrefix(p, "/") {
+		return "", errorsmod.Wrap(ErrAbsolutePath, p)
+	}
+
+	segments := strings.Split(p, "/")
+	canonical := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		switch segment {
+		case "":
+			return "", errorsmod.Wrap(ErrEmptyPathSegment, p)
+		case "..":
+			return "", errorsmod.Wrap(ErrPathTraversal, p)
+		case ".":
+			continue
+		}
+		canonical = append(canonical, segment)
+	}
+	if len(canonical) == 0 {
+		return "", errorsmod.Wrap(ErrEmptyPath, p)
+	}
+
+	return strings.Join(canonical, "/"), nil
+}
+
+// IsPathError returns true if the error is one of the item path errors.
+func IsPathError(err error) bool {
+	return errorsmod.IsOf(err, ErrEmptyPath, ErrAbsolutePath, ErrPathTraversal, ErrPathBackslash, ErrEmptyPathSegment,
+		ErrPathNulByte, ErrDuplicatePath)
+}
+
+// NormalizePath returns the cleaned form of a local path, i.e., without `.` segments, redundant slashes and trailing
+// slash. Clients use it to record paths relative to the root of a payload; see CanonicalPath for the validation
+// applied by the module.
+func NormalizePath(p string) string {
+	if p == "" {
+		return ""
+	}
+	return path.Clean(p)
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"ghostcloud/x/ghostcloud/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+		err      error
+	}{
+		{path: "index.html", expected: "index.html"},
+		{path: "assets/css/style.css", expected: "assets/css/style.css"},
+		{path: "./index.html", expected: "index.html"},
+		{path: "assets/./app.js", expected: "assets/app.js"},
+		{path: "..foo/bar..", expected: "..foo/bar.."},
+		{path: "", err: types.ErrEmptyPath},
+		{path: ".", err: types.ErrEmptyPath},
+		{path: "/index.html", err: types.ErrAbsolutePath},
+		{path: "../index.html", err: types.ErrPathTraversal},
+		{path: "assets/../../index.html", err: types.ErrPathTraversal},
+		{path: `assets\app.js`, err: types.ErrPathBackslash},
+		{path: "assets//app.js", err: types.ErrEmptyPathSegment},
+		{path: "assets/", err: types.ErrEmptyPathSegment},
+		{path: "index.html\x00.js", err: types.ErrPathNulByte},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			path, err := types.CanonicalPath(tt.path)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				require.True(t, types.IsPathError(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, path)
+		})
+	}
+}